@@ -0,0 +1,113 @@
+package battle
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// MaxTurns ends battles where neither side can land a hit.
+const MaxTurns = 100
+
+// CritChance is the 1 in N chance of a critical hit.
+const CritChance = 24
+
+// Chooser picks the index of the move self uses against foe this turn.
+type Chooser func(self, foe *Battler) int
+
+// RandomChooser picks any of self's moves.
+func RandomChooser(r *rand.Rand) Chooser {
+	return func(self, foe *Battler) int {
+		return r.Intn(len(self.Moves))
+	}
+}
+
+type Battle struct {
+	A, B  *Battler
+	Chart TypeChart
+	Rand  *rand.Rand
+	Log   func(string)
+}
+
+// Run fights turn by turn until one side faints, returning the winner, or
+// nil if MaxTurns pass without one.
+func (b *Battle) Run(chooseA, chooseB Chooser) *Battler {
+	for turn := 1; turn <= MaxTurns; turn++ {
+		b.logf("-- turn %d: %s %d/%d HP, %s %d/%d HP --", turn,
+			b.A.Name, b.A.HP, b.A.Stats.HP, b.B.Name, b.B.HP, b.B.Stats.HP)
+
+		ma := b.A.Moves[chooseA(b.A, b.B)]
+		mb := b.B.Moves[chooseB(b.B, b.A)]
+
+		first, second := b.A, b.B
+		fm, sm := ma, mb
+		if b.goesSecond(b.A, ma, b.B, mb) {
+			first, second = b.B, b.A
+			fm, sm = mb, ma
+		}
+
+		b.attack(first, second, fm)
+		if second.Fainted() {
+			b.logf("%s fainted!", second.Name)
+			return first
+		}
+		b.attack(second, first, sm)
+		if first.Fainted() {
+			b.logf("%s fainted!", first.Name)
+			return second
+		}
+	}
+
+	b.logf("The battle dragged on too long and ended in a draw.")
+	return nil
+}
+
+// goesSecond orders the turn by move priority, then speed, with speed ties
+// decided at random.
+func (b *Battle) goesSecond(x *Battler, mx Move, y *Battler, my Move) bool {
+	if mx.Priority != my.Priority {
+		return mx.Priority < my.Priority
+	}
+	if x.Stats.Speed != y.Stats.Speed {
+		return x.Stats.Speed < y.Stats.Speed
+	}
+	return b.Rand.Intn(2) == 0
+}
+
+func (b *Battle) attack(att, def *Battler, m Move) {
+	b.logf("%s used %s!", att.Name, m.Name)
+
+	if m.Power <= 0 {
+		b.logf("But nothing happened.")
+		return
+	}
+	if m.Accuracy > 0 && b.Rand.Intn(100) >= m.Accuracy {
+		b.logf("%s's attack missed!", att.Name)
+		return
+	}
+
+	eff := b.Chart.Effectiveness(m.Type, def.Types)
+	if eff == 0 {
+		b.logf("It doesn't affect %s...", def.Name)
+		return
+	}
+
+	crit := b.Rand.Intn(CritChance) == 0
+	dmg := Damage(att, def, m, b.Chart, crit, 0.85+0.15*b.Rand.Float64())
+	def.HP = max(def.HP-dmg, 0)
+
+	if crit {
+		b.logf("A critical hit!")
+	}
+	if eff > 1 {
+		b.logf("It's super effective!")
+	} else if eff < 1 {
+		b.logf("It's not very effective...")
+	}
+	b.logf("%s took %d damage.", def.Name, dmg)
+}
+
+func (b *Battle) logf(format string, args ...any) {
+	if b.Log != nil {
+		b.Log(fmt.Sprintf(format, args...))
+	}
+}
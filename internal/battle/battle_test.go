@@ -0,0 +1,65 @@
+package battle
+
+import (
+	"math/rand"
+	"testing"
+)
+
+var testChart = TypeChart{
+	"electric": {"flying": 2, "ground": 0, "electric": 0.5},
+}
+
+func TestEffectiveness(t *testing.T) {
+	if v := testChart.Effectiveness("electric", []string{"normal", "flying"}); v != 2 {
+		t.Fatalf("electric vs normal/flying = %v, want 2", v)
+	}
+	if v := testChart.Effectiveness("electric", []string{"ground", "flying"}); v != 0 {
+		t.Fatalf("electric vs ground/flying = %v, want 0", v)
+	}
+	if v := testChart.Effectiveness("normal", []string{"flying"}); v != 1 {
+		t.Fatalf("unlisted pair = %v, want 1", v)
+	}
+}
+
+func TestDamage(t *testing.T) {
+	stats := Stats{HP: 50, Attack: 50, Defense: 50, SpAttack: 50, SpDefense: 50, Speed: 50}
+	att := NewBattler("pikachu", 50, []string{"electric"}, stats, nil)
+	def := NewBattler("pidgey", 50, []string{"normal", "flying"}, stats, nil)
+	shock := Move{Name: "thunder-shock", Type: "electric", Power: 40, DamageClass: "special"}
+
+	// (2*50/5+2)*40*50/50/50+2 = 19, then x1.5 STAB x2 type
+	if d := Damage(att, def, shock, testChart, false, 1); d != 57 {
+		t.Fatalf("damage = %d, want 57", d)
+	}
+	if d := Damage(att, def, shock, testChart, true, 1); d != 85 {
+		t.Fatalf("critical damage = %d, want 85", d)
+	}
+}
+
+func TestCalcStats(t *testing.T) {
+	// pikachu at level 50 with perfect IVs and max speed EVs and a boosting nature
+	if hp := CalcHP(35, 31, 0, 50); hp != 110 {
+		t.Fatalf("hp = %d, want 110", hp)
+	}
+	if spe := CalcStat(90, 31, 252, 50, 1.1); spe != 156 {
+		t.Fatalf("speed = %d, want 156", spe)
+	}
+}
+
+func TestRunHasWinner(t *testing.T) {
+	strong := NewBattler("strong", 50, []string{"electric"},
+		Stats{HP: 200, Attack: 200, Defense: 200, SpAttack: 200, SpDefense: 200, Speed: 200},
+		[]Move{{Name: "thunder-shock", Type: "electric", Power: 40, DamageClass: "special"}})
+	weak := NewBattler("weak", 5, []string{"flying"},
+		Stats{HP: 20, Attack: 10, Defense: 10, SpAttack: 10, SpDefense: 10, Speed: 10}, nil)
+
+	r := rand.New(rand.NewSource(1))
+	b := Battle{A: strong, B: weak, Chart: testChart, Rand: r}
+	winner := b.Run(RandomChooser(r), RandomChooser(r))
+	if winner != strong {
+		t.Fatal("stronger battler did not win")
+	}
+	if !weak.Fainted() {
+		t.Fatal("loser did not faint")
+	}
+}
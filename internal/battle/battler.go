@@ -0,0 +1,57 @@
+package battle
+
+// Move is a move as far as the battle engine cares. Accuracy 0 means the
+// move never misses.
+type Move struct {
+	Name        string
+	Type        string
+	Power       int
+	Accuracy    int
+	DamageClass string
+	Priority    int
+}
+
+// Struggle is used by pokemon without any damaging move.
+var Struggle = Move{Name: "struggle", Type: "typeless", Power: 50, DamageClass: "physical"}
+
+// Stats are a pokemon's actual stats at its level.
+type Stats struct {
+	HP        int
+	Attack    int
+	Defense   int
+	SpAttack  int
+	SpDefense int
+	Speed     int
+}
+
+// Battler is one side of a battle.
+type Battler struct {
+	Name  string
+	Level int
+	Types []string
+	Stats Stats
+	Moves []Move
+	HP    int
+}
+
+func NewBattler(name string, level int, types []string, stats Stats, moves []Move) *Battler {
+	if len(moves) <= 0 {
+		moves = []Move{Struggle}
+	}
+	return &Battler{name, level, types, stats, moves, stats.HP}
+}
+
+func (b *Battler) Fainted() bool {
+	return b.HP <= 0
+}
+
+// CalcHP is the max HP at level for a base HP stat.
+func CalcHP(base, iv, ev, level int) int {
+	return (2*base+iv+ev/4)*level/100 + level + 10
+}
+
+// CalcStat is a non-HP stat at level for a base stat, scaled by the nature
+// modifier (0.9, 1 or 1.1).
+func CalcStat(base, iv, ev, level int, nature float64) int {
+	return int(float64((2*base+iv+ev/4)*level/100+5) * nature)
+}
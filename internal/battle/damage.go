@@ -0,0 +1,50 @@
+package battle
+
+// TypeChart maps an attacking type to the multiplier against each
+// defending type. Pairs that are missing deal normal damage.
+type TypeChart map[string]map[string]float64
+
+// Effectiveness is the combined multiplier of an attacking type against a
+// defender with defTypes.
+func (c TypeChart) Effectiveness(atkType string, defTypes []string) float64 {
+	m := 1.0
+	for _, t := range defTypes {
+		if v, ok := c[atkType][t]; ok {
+			m *= v
+		}
+	}
+	return m
+}
+
+// Damage applies the generation V+ damage formula. crit and roll, the random
+// factor in [0.85, 1], are decided by the caller.
+func Damage(att, def *Battler, m Move, chart TypeChart, crit bool, roll float64) int {
+	if m.Power <= 0 {
+		return 0
+	}
+
+	a, d := att.Stats.Attack, def.Stats.Defense
+	if m.DamageClass == "special" {
+		a, d = att.Stats.SpAttack, def.Stats.SpDefense
+	}
+	d = max(d, 1)
+
+	base := (2*att.Level/5+2)*m.Power*a/d/50 + 2
+
+	mod := roll * chart.Effectiveness(m.Type, def.Types)
+	if crit {
+		mod *= 1.5
+	}
+	for _, t := range att.Types {
+		if t == m.Type {
+			mod *= 1.5
+			break
+		}
+	}
+
+	dmg := int(float64(base) * mod)
+	if dmg <= 0 && mod > 0 {
+		dmg = 1
+	}
+	return dmg
+}
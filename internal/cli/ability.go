@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+func commandAbility(cfg *config.Clicfg, args []string) error {
+	if len(args) <= 0 || args[0] == "" {
+		return errors.New("no ability argument given")
+	}
+
+	respData, err := cfg.API.GetAbility(args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Name: %s\n", respData.Name)
+	if e, ok := pokeapi.EnglishEffect(respData.EffectEntries); ok {
+		fmt.Printf("Short effect: %s\n", e.ShortEffect)
+		fmt.Printf("Effect: %s\n", e.Effect)
+	}
+
+	fmt.Printf("Pokemon:\n")
+	for _, p := range respData.Pokemon {
+		if p.IsHidden {
+			fmt.Printf("  - %s (hidden)\n", p.Pokemon.Name)
+		} else {
+			fmt.Printf("  - %s\n", p.Pokemon.Name)
+		}
+	}
+
+	return nil
+}
+
+// rollAbility picks one of poke's regular abilities at random. Hidden
+// abilities are only used by pokemon that have nothing else.
+func rollAbility(poke pokeapi.Pokemon, roll func(n int) int) string {
+	regular := []string{}
+	for _, a := range poke.Abilities {
+		if !a.IsHidden {
+			regular = append(regular, a.Ability.Name)
+		}
+	}
+	if len(regular) > 0 {
+		return regular[roll(len(regular))]
+	}
+	if len(poke.Abilities) > 0 {
+		return poke.Abilities[roll(len(poke.Abilities))].Ability.Name
+	}
+	return ""
+}
+
+// evolvedAbility is the ability in the same slot of the evolved species, as
+// evolution keeps the slot rather than the ability itself. It is empty when
+// ability is not one of from's or to has nothing in its slot, evolve rolls a
+// fresh one then.
+func evolvedAbility(from, to pokeapi.Pokemon, ability string) string {
+	slot := 0
+	for _, a := range from.Abilities {
+		if a.Ability.Name == ability {
+			slot = a.Slot
+		}
+	}
+	for _, a := range to.Abilities {
+		if a.Slot == slot {
+			return a.Ability.Name
+		}
+	}
+	return ""
+}
+
+// abilityRoller rolls abilities for pokemon migrated from older saves.
+func abilityRoller(cfg *config.Clicfg) config.AbilityRoller {
+	return func(species string) (string, error) {
+		poke, err := cfg.API.GetPokemon(species)
+		if err != nil {
+			return "", err
+		}
+		return rollAbility(poke, rand.Intn), nil
+	}
+}
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+// abilities lists names in slots 1-3, with the third the hidden ability.
+func abilities(names ...string) []pokeapi.PokemonAbility {
+	list := []pokeapi.PokemonAbility{}
+	for i, name := range names {
+		list = append(list, pokeapi.PokemonAbility{
+			Slot:     i + 1,
+			IsHidden: i == 2,
+			Ability:  pokeapi.NamedAPIResource{Name: name},
+		})
+	}
+	return list
+}
+
+func TestRollAbility(t *testing.T) {
+	poke := pokeapi.Pokemon{Abilities: abilities("static", "volt-absorb", "lightning-rod")}
+	if a := rollAbility(poke, func(n int) int { return n - 1 }); a != "volt-absorb" {
+		t.Fatalf("rolled %q, hidden abilities should not be picked", a)
+	}
+
+	if a := rollAbility(pokeapi.Pokemon{}, func(n int) int { return 0 }); a != "" {
+		t.Fatalf("pokemon without abilities rolled %q", a)
+	}
+}
+
+func TestEvolvedAbility(t *testing.T) {
+	from := pokeapi.Pokemon{Abilities: abilities("keen-eye", "tangled-feet", "big-pecks")}
+	to := pokeapi.Pokemon{Abilities: abilities("keen-eye", "tangled-feet", "big-pecks")}
+	to.Abilities[0].Ability.Name = "intimidate"
+
+	if a := evolvedAbility(from, to, "keen-eye"); a != "intimidate" {
+		t.Fatalf("got %q, want the ability in the same slot", a)
+	}
+	if a := evolvedAbility(from, to, "tangled-feet"); a != "tangled-feet" {
+		t.Fatalf("got %q", a)
+	}
+	if a := evolvedAbility(from, to, "levitate"); a != "" {
+		t.Fatalf("got %q for an ability the species does not have", a)
+	}
+}
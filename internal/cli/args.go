@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// parseFlags splits command arguments into positional arguments and
+// "--name value" or "--name=value" options. Flags in boolFlags take no value
+// and are recorded as "true"; any flag not in valueFlags or boolFlags is an
+// error.
+func parseFlags(args []string, valueFlags []string, boolFlags []string) ([]string, map[string]string, error) {
+	positional := []string{}
+	flags := map[string]string{}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "" {
+			continue
+		}
+		if !strings.HasPrefix(arg, "--") {
+			positional = append(positional, arg)
+			continue
+		}
+
+		name, val, hasVal := strings.Cut(strings.TrimPrefix(arg, "--"), "=")
+		switch {
+		case slices.Contains(boolFlags, name):
+			if hasVal {
+				return nil, nil, fmt.Errorf("flag --%s takes no value", name)
+			}
+			flags[name] = "true"
+		case slices.Contains(valueFlags, name):
+			if !hasVal {
+				i++
+				for i < len(args) && args[i] == "" {
+					i++
+				}
+				if i >= len(args) {
+					return nil, nil, fmt.Errorf("flag --%s needs a value", name)
+				}
+				val = args[i]
+			}
+			flags[name] = val
+		default:
+			return nil, nil, fmt.Errorf("unknown flag --%s", name)
+		}
+	}
+
+	return positional, flags, nil
+}
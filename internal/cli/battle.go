@@ -0,0 +1,197 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/battle"
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+// maxMoves is how many moves a pokemon brings into battle.
+const maxMoves = 4
+
+func commandBattle(cfg *config.Clicfg, args []string) error {
+	args, _, err := parseFlags(args, nil, nil)
+	if err != nil {
+		return err
+	}
+	if len(args) <= 0 {
+		return errors.New("usage: battle <id> [opponent id], without an opponent you battle the wild pokemon")
+	}
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	mine, err := partyPokemon(cfg, args[0])
+	if err != nil {
+		return err
+	}
+	a, _, err := newBattler(cfg, mine, mine.DisplayName())
+	if err != nil {
+		return err
+	}
+
+	var b *battle.Battler
+	var other *config.Pokemon
+	var foe pokeapi.Pokemon
+	if len(args) > 1 {
+		other, err = partyPokemon(cfg, args[1])
+		if err != nil {
+			return err
+		}
+		if other == mine {
+			return errors.New("a pokemon cannot battle itself")
+		}
+		b, _, err = newBattler(cfg, other, other.DisplayName())
+	} else {
+		if cfg.Wild == nil || cfg.Wild.Location != cfg.Location {
+			return errors.New("there is no wild pokemon to battle, find one with: encounter")
+		}
+		wild := &config.Pokemon{Species: cfg.Wild.Name, Level: cfg.Wild.Level}
+		config.RollGenes(wild, r.Intn)
+		b, foe, err = newBattler(cfg, wild, "wild "+cfg.Wild.Name)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, chart, err := typeChart(cfg)
+	if err != nil {
+		return err
+	}
+
+	fight := battle.Battle{A: a, B: b, Chart: chart, Rand: r, Log: func(s string) { fmt.Println(s) }}
+
+	fmt.Printf("%s (Lv. %d) vs %s (Lv. %d)!\n", a.Name, a.Level, b.Name, b.Level)
+
+	chooseA := battle.RandomChooser(r)
+	if other == nil && cfg.Input != nil {
+		chooseA = promptChooser(cfg)
+	}
+	winner := fight.Run(chooseA, battle.RandomChooser(r))
+
+	if other != nil {
+		if winner != nil {
+			fmt.Printf("%s wins the practice match!\n", winner.Name)
+		}
+		return nil
+	}
+
+	wild := cfg.Wild
+	cfg.Wild = nil
+	switch winner {
+	case a:
+		prize := wild.Level * 20
+		cfg.Money += prize
+		fmt.Printf("You defeated the wild %s and found $%d!\n", wild.Name, prize)
+		return gainExperience(cfg, mine, foe, wild.Level)
+	case b:
+		fmt.Printf("%s is too tired to go on, you retreat and the wild %s wanders off.\n", a.Name, wild.Name)
+	default:
+		fmt.Printf("The wild %s got bored and wandered off.\n", wild.Name)
+	}
+
+	return nil
+}
+
+// partyPokemon finds the party pokemon with the id given as s.
+func partyPokemon(cfg *config.Clicfg, s string) (*config.Pokemon, error) {
+	id, err := parsePokemonID(s)
+	if err != nil {
+		return nil, err
+	}
+	p, box, err := cfg.FindPokemon(id)
+	if err != nil {
+		return nil, err
+	}
+	if box != 0 {
+		return nil, fmt.Errorf("%s is in box %d, withdraw it first", p.DisplayName(), box)
+	}
+	return p, nil
+}
+
+// newBattler builds a battler for mon from its species' PokeAPI data,
+// bringing the last damaging moves it learned by leveling up.
+func newBattler(cfg *config.Clicfg, mon *config.Pokemon, name string) (*battle.Battler, pokeapi.Pokemon, error) {
+	p, err := cfg.API.GetPokemon(mon.Species)
+	if err != nil {
+		return nil, p, err
+	}
+
+	types := []string{}
+	for _, t := range p.Types {
+		types = append(types, t.Type.Name)
+	}
+
+	moves, err := battleMoves(cfg, p, mon.Level)
+	if err != nil {
+		return nil, p, err
+	}
+
+	return battle.NewBattler(name, mon.Level, types, actualStats(p, mon), moves), p, nil
+}
+
+// battleMoves returns up to maxMoves damaging moves p learned by leveling up
+// to level in the latest version group, most recently learned first.
+func battleMoves(cfg *config.Clicfg, p pokeapi.Pokemon, level int) ([]battle.Move, error) {
+	rows := learnset(p, latestVersionGroup(p), "level-up")
+
+	moves := []battle.Move{}
+	for i := len(rows) - 1; i >= 0 && len(moves) < maxMoves; i-- {
+		if rows[i].LevelLearnedAt > level {
+			continue
+		}
+		m, err := cfg.API.GetMove(rows[i].move)
+		if err != nil {
+			return nil, err
+		}
+		if m.Power == nil || *m.Power <= 0 {
+			continue
+		}
+		moves = append(moves, battleMove(m))
+	}
+
+	return moves, nil
+}
+
+func battleMove(m pokeapi.Move) battle.Move {
+	bm := battle.Move{
+		Name:        m.Name,
+		Type:        m.Type.Name,
+		DamageClass: m.DamageClass.Name,
+		Priority:    m.Priority,
+	}
+	if m.Power != nil {
+		bm.Power = *m.Power
+	}
+	if m.Accuracy != nil {
+		bm.Accuracy = *m.Accuracy
+	}
+	return bm
+}
+
+// promptChooser lets the trainer pick each move on the REPL input.
+func promptChooser(cfg *config.Clicfg) battle.Chooser {
+	return func(self, foe *battle.Battler) int {
+		for {
+			fmt.Printf("What will %s do?\n", self.Name)
+			for i, m := range self.Moves {
+				fmt.Printf("  %d) %s (%s, power %d)\n", i+1, m.Name, m.Type, m.Power)
+			}
+			fmt.Print("> ")
+			if !cfg.Input.Scan() {
+				return 0
+			}
+
+			n, err := strconv.Atoi(strings.TrimSpace(cfg.Input.Text()))
+			if err == nil && n >= 1 && n <= len(self.Moves) {
+				return n - 1
+			}
+		}
+	}
+}
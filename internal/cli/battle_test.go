@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/battle"
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+// learnedMove is a PokemonMove learned by leveling up in the given version
+// groups, keyed by version group ID.
+func learnedMove(name string, levels map[int]int) pokeapi.PokemonMove {
+	m := pokeapi.PokemonMove{Move: pokeapi.NamedAPIResource{Name: name}}
+	for id, level := range levels {
+		m.VersionGroupDetails = append(m.VersionGroupDetails, pokeapi.PokemonMoveVersion{
+			LevelLearnedAt:  level,
+			VersionGroup:    pokeapi.NamedAPIResource{Name: fmt.Sprintf("vg-%d", id), URL: fmt.Sprintf("%sversion-group/%d/", testBaseURL, id)},
+			MoveLearnMethod: pokeapi.NamedAPIResource{Name: "level-up"},
+		})
+	}
+	return m
+}
+
+func battleTestCfg() *config.Clicfg {
+	return testCfg(mapFetcher{
+		"move/tackle":        `{"name":"tackle","power":40,"accuracy":100,"type":{"name":"normal"},"damage_class":{"name":"physical"}}`,
+		"move/thunder-shock": `{"name":"thunder-shock","power":40,"accuracy":100,"type":{"name":"electric"},"damage_class":{"name":"special"}}`,
+		"move/thunderbolt":   `{"name":"thunderbolt","power":90,"accuracy":100,"type":{"name":"electric"},"damage_class":{"name":"special"}}`,
+		"move/growl":         `{"name":"growl","power":null,"accuracy":100,"type":{"name":"normal"},"damage_class":{"name":"status"}}`,
+		"pokemon/pikachu": `{"name":"pikachu","types":[{"slot":1,"type":{"name":"electric"}}],
+			"stats":[{"base_stat":35,"stat":{"name":"hp"}},{"base_stat":90,"stat":{"name":"speed"}}],
+			"moves":[{"move":{"name":"tackle"},"version_group_details":[{"level_learned_at":1,"version_group":{"name":"vg-1","url":"` + testBaseURL + `version-group/1/"},"move_learn_method":{"name":"level-up"}}]}]}`,
+	})
+}
+
+func TestBattleMoves(t *testing.T) {
+	p := pokeapi.Pokemon{Moves: []pokeapi.PokemonMove{
+		learnedMove("tackle", map[int]int{1: 1, 20: 1}),
+		learnedMove("thunder-shock", map[int]int{1: 1, 20: 5}),
+		// only learned early enough in the latest version group
+		learnedMove("thunderbolt", map[int]int{1: 30, 20: 10}),
+		// status moves deal no damage
+		learnedMove("growl", map[int]int{20: 1}),
+		// not learnable in the latest version group
+		learnedMove("quick-attack", map[int]int{1: 5}),
+		learnedMove("thunder", map[int]int{20: 50}),
+	}}
+
+	moves, err := battleMoves(battleTestCfg(), p, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := []string{}
+	for _, m := range moves {
+		names = append(names, m.Name)
+	}
+	if want := []string{"thunderbolt", "thunder-shock", "tackle"}; !slices.Equal(names, want) {
+		t.Fatalf("got moves %v, want %v", names, want)
+	}
+	if moves[0].Power != 90 || moves[0].Type != "electric" || moves[0].DamageClass != "special" {
+		t.Fatalf("move not converted: %+v", moves[0])
+	}
+}
+
+func TestBattleMovesCap(t *testing.T) {
+	p := pokeapi.Pokemon{}
+	for range maxMoves + 2 {
+		p.Moves = append(p.Moves, learnedMove("tackle", map[int]int{1: 1}))
+	}
+	moves, err := battleMoves(battleTestCfg(), p, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(moves) != maxMoves {
+		t.Fatalf("got %d moves, want %d", len(moves), maxMoves)
+	}
+}
+
+func TestNewBattler(t *testing.T) {
+	mon := &config.Pokemon{Species: "pikachu", Level: 10}
+	b, p, err := newBattler(battleTestCfg(), mon, "Sparky")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "pikachu" || b.Name != "Sparky" || b.Level != 10 {
+		t.Fatalf("got battler %s Lv. %d from %s", b.Name, b.Level, p.Name)
+	}
+	if !slices.Equal(b.Types, []string{"electric"}) {
+		t.Fatalf("got types %v", b.Types)
+	}
+	if b.HP != battle.CalcHP(35, 0, 0, 10) || b.HP != b.Stats.HP {
+		t.Fatalf("got %d HP", b.HP)
+	}
+	if len(b.Moves) != 1 || b.Moves[0].Name != "tackle" {
+		t.Fatalf("got moves %v", b.Moves)
+	}
+
+	if _, _, err := newBattler(battleTestCfg(), &config.Pokemon{Species: "missingno", Level: 5}, "?"); err == nil {
+		t.Fatal("expected an error for an unknown species")
+	}
+}
+
+func TestPartyPokemon(t *testing.T) {
+	cfg := &config.Clicfg{}
+	for range config.PartySize + 1 {
+		cfg.Catch("pidgey", 3, "")
+	}
+
+	if p, err := partyPokemon(cfg, "#2"); err != nil || p.ID != 2 {
+		t.Fatalf("got %v, %v", p, err)
+	}
+	if _, err := partyPokemon(cfg, fmt.Sprint(config.PartySize+1)); err == nil {
+		t.Fatal("expected an error for a boxed pokemon")
+	}
+	if _, err := partyPokemon(cfg, "pidgey"); err == nil {
+		t.Fatal("expected an error for an invalid id")
+	}
+}
@@ -0,0 +1,55 @@
+package cli
+
+import "math"
+
+// captureStatus is a non-volatile status the wild pokemon may have, making
+// it easier to catch.
+type captureStatus struct {
+	name  string
+	bonus float64
+}
+
+var captureStatuses = []captureStatus{
+	{"asleep", 2},
+	{"frozen", 2},
+	{"paralyzed", 1.5},
+	{"burned", 1.5},
+	{"poisoned", 1.5},
+}
+
+// masterBallBonus is the ball bonus at which a catch can no longer fail.
+const masterBallBonus = 255
+
+type captureAttempt struct {
+	captureRate int
+	ballBonus   float64
+	maxHP       int
+	hp          int
+	statusBonus float64
+}
+
+// captureShakes applies the generation III/IV capture formula, returning how
+// many times the ball shook and whether the pokemon was caught. roll returns
+// a random int in [0, n).
+func captureShakes(a captureAttempt, roll func(n int) int) (int, bool) {
+	if a.ballBonus >= masterBallBonus {
+		return 3, true
+	}
+
+	x := float64(3*a.maxHP-2*a.hp) * float64(a.captureRate) * a.ballBonus / float64(3*a.maxHP) * a.statusBonus
+	if x >= 255 {
+		return 3, true
+	}
+	if x <= 0 {
+		return 0, false
+	}
+
+	// four shake checks must all pass; the fourth is the ball clicking shut
+	b := 1048560 / math.Sqrt(math.Sqrt(16711680/x))
+	for i := 0; i < 4; i++ {
+		if float64(roll(65536)) >= b {
+			return min(i, 3), false
+		}
+	}
+	return 3, true
+}
@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+func TestCaptureMasterBall(t *testing.T) {
+	a := captureAttempt{captureRate: 3, ballBonus: masterBallBonus, maxHP: 100, hp: 100, statusBonus: 1}
+	shakes, caught := captureShakes(a, func(n int) int { return n - 1 })
+	if !caught || shakes != 3 {
+		t.Fatalf("master ball failed: %d shakes, caught %v", shakes, caught)
+	}
+}
+
+func TestCaptureGuaranteed(t *testing.T) {
+	a := captureAttempt{captureRate: 255, ballBonus: 1, maxHP: 100, hp: 1, statusBonus: 2}
+	_, caught := captureShakes(a, func(n int) int { return n - 1 })
+	if !caught {
+		t.Fatal("capture rate 255 at 1 HP while asleep should always catch")
+	}
+}
+
+func TestCaptureShakeCount(t *testing.T) {
+	a := captureAttempt{captureRate: 3, ballBonus: 1, maxHP: 100, hp: 100, statusBonus: 1}
+
+	shakes, caught := captureShakes(a, func(n int) int { return n - 1 })
+	if caught || shakes != 0 {
+		t.Fatalf("worst rolls should escape immediately: %d shakes, caught %v", shakes, caught)
+	}
+
+	rolls := 0
+	shakes, caught = captureShakes(a, func(n int) int {
+		rolls++
+		if rolls <= 2 {
+			return 0
+		}
+		return n - 1
+	})
+	if caught || shakes != 2 {
+		t.Fatalf("expected escape after 2 shakes: %d shakes, caught %v", shakes, caught)
+	}
+}
+
+func TestParseFlags(t *testing.T) {
+	pos, flags, err := parseFlags([]string{"pikachu", "", "--ball", "great-ball", "--detail"}, []string{"ball"}, []string{"detail"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pos) != 1 || pos[0] != "pikachu" {
+		t.Fatalf("unexpected positional args %v", pos)
+	}
+	if flags["ball"] != "great-ball" || flags["detail"] != "true" {
+		t.Fatalf("unexpected flags %v", flags)
+	}
+
+	if _, _, err := parseFlags([]string{"--nope"}, nil, nil); err == nil {
+		t.Fatal("expected unknown flag error")
+	}
+	if _, _, err := parseFlags([]string{"--ball"}, []string{"ball"}, nil); err == nil {
+		t.Fatal("expected missing value error")
+	}
+}
+
+func TestBallBonus(t *testing.T) {
+	great := pokeapi.Item{Name: "great-ball", Category: pokeapi.NamedAPIResource{Name: "standard-balls"}}
+	great.EffectEntries = []pokeapi.VerboseEffect{{
+		Effect:   "Used in battle\n:   Attempts to catch a wild Pokémon, using a catch rate of 1.5×.",
+		Language: pokeapi.NamedAPIResource{Name: "en"},
+	}}
+	if v, err := ballBonus(great); err != nil || v != 1.5 {
+		t.Fatalf("great ball bonus %v, %v", v, err)
+	}
+
+	master := pokeapi.Item{Name: "master-ball", Category: pokeapi.NamedAPIResource{Name: "standard-balls"}}
+	if v, _ := ballBonus(master); v != masterBallBonus {
+		t.Fatalf("master ball bonus %v without effect text", v)
+	}
+
+	potion := pokeapi.Item{Name: "potion", Category: pokeapi.NamedAPIResource{Name: "healing"}}
+	if _, err := ballBonus(potion); err == nil {
+		t.Fatal("expected potion to be rejected as a ball")
+	}
+}
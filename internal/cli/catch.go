@@ -1,327 +1,150 @@
 package cli
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"math/rand"
-	"net/http"
+	"strings"
 
 	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
 )
 
-type pokemonData struct {
-	ID             int    `json:"id,omitempty"`
-	Name           string `json:"name,omitempty"`
-	BaseExperience int    `json:"base_experience,omitempty"`
-	Height         int    `json:"height,omitempty"`
-	IsDefault      bool   `json:"is_default,omitempty"`
-	Order          int    `json:"order,omitempty"`
-	Weight         int    `json:"weight,omitempty"`
-	Abilities      []struct {
-		IsHidden bool `json:"is_hidden,omitempty"`
-		Slot     int  `json:"slot,omitempty"`
-		Ability  struct {
-			Name string `json:"name,omitempty"`
-			URL  string `json:"url,omitempty"`
-		} `json:"ability,omitempty"`
-	} `json:"abilities,omitempty"`
-	Forms []struct {
-		Name string `json:"name,omitempty"`
-		URL  string `json:"url,omitempty"`
-	} `json:"forms,omitempty"`
-	GameIndices []struct {
-		GameIndex int `json:"game_index,omitempty"`
-		Version   struct {
-			Name string `json:"name,omitempty"`
-			URL  string `json:"url,omitempty"`
-		} `json:"version,omitempty"`
-	} `json:"game_indices,omitempty"`
-	HeldItems []struct {
-		Item struct {
-			Name string `json:"name,omitempty"`
-			URL  string `json:"url,omitempty"`
-		} `json:"item,omitempty"`
-		VersionDetails []struct {
-			Rarity  int `json:"rarity,omitempty"`
-			Version struct {
-				Name string `json:"name,omitempty"`
-				URL  string `json:"url,omitempty"`
-			} `json:"version,omitempty"`
-		} `json:"version_details,omitempty"`
-	} `json:"held_items,omitempty"`
-	LocationAreaEncounters string `json:"location_area_encounters,omitempty"`
-	Moves                  []struct {
-		Move struct {
-			Name string `json:"name,omitempty"`
-			URL  string `json:"url,omitempty"`
-		} `json:"move,omitempty"`
-		VersionGroupDetails []struct {
-			LevelLearnedAt int `json:"level_learned_at,omitempty"`
-			VersionGroup   struct {
-				Name string `json:"name,omitempty"`
-				URL  string `json:"url,omitempty"`
-			} `json:"version_group,omitempty"`
-			MoveLearnMethod struct {
-				Name string `json:"name,omitempty"`
-				URL  string `json:"url,omitempty"`
-			} `json:"move_learn_method,omitempty"`
-		} `json:"version_group_details,omitempty"`
-	} `json:"moves,omitempty"`
-	Species struct {
-		Name string `json:"name,omitempty"`
-		URL  string `json:"url,omitempty"`
-	} `json:"species,omitempty"`
-	Sprites struct {
-		BackDefault      string `json:"back_default,omitempty"`
-		BackFemale       any    `json:"back_female,omitempty"`
-		BackShiny        string `json:"back_shiny,omitempty"`
-		BackShinyFemale  any    `json:"back_shiny_female,omitempty"`
-		FrontDefault     string `json:"front_default,omitempty"`
-		FrontFemale      any    `json:"front_female,omitempty"`
-		FrontShiny       string `json:"front_shiny,omitempty"`
-		FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
-		Other            struct {
-			DreamWorld struct {
-				FrontDefault string `json:"front_default,omitempty"`
-				FrontFemale  any    `json:"front_female,omitempty"`
-			} `json:"dream_world,omitempty"`
-			Home struct {
-				FrontDefault     string `json:"front_default,omitempty"`
-				FrontFemale      any    `json:"front_female,omitempty"`
-				FrontShiny       string `json:"front_shiny,omitempty"`
-				FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
-			} `json:"home,omitempty"`
-			OfficialArtwork struct {
-				FrontDefault string `json:"front_default,omitempty"`
-				FrontShiny   string `json:"front_shiny,omitempty"`
-			} `json:"official-artwork,omitempty"`
-			Showdown struct {
-				BackDefault      string `json:"back_default,omitempty"`
-				BackFemale       any    `json:"back_female,omitempty"`
-				BackShiny        string `json:"back_shiny,omitempty"`
-				BackShinyFemale  any    `json:"back_shiny_female,omitempty"`
-				FrontDefault     string `json:"front_default,omitempty"`
-				FrontFemale      any    `json:"front_female,omitempty"`
-				FrontShiny       string `json:"front_shiny,omitempty"`
-				FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
-			} `json:"showdown,omitempty"`
-		} `json:"other,omitempty"`
-		Versions struct {
-			GenerationI struct {
-				RedBlue struct {
-					BackDefault  string `json:"back_default,omitempty"`
-					BackGray     string `json:"back_gray,omitempty"`
-					FrontDefault string `json:"front_default,omitempty"`
-					FrontGray    string `json:"front_gray,omitempty"`
-				} `json:"red-blue,omitempty"`
-				Yellow struct {
-					BackDefault  string `json:"back_default,omitempty"`
-					BackGray     string `json:"back_gray,omitempty"`
-					FrontDefault string `json:"front_default,omitempty"`
-					FrontGray    string `json:"front_gray,omitempty"`
-				} `json:"yellow,omitempty"`
-			} `json:"generation-i,omitempty"`
-			GenerationIi struct {
-				Crystal struct {
-					BackDefault  string `json:"back_default,omitempty"`
-					BackShiny    string `json:"back_shiny,omitempty"`
-					FrontDefault string `json:"front_default,omitempty"`
-					FrontShiny   string `json:"front_shiny,omitempty"`
-				} `json:"crystal,omitempty"`
-				Gold struct {
-					BackDefault  string `json:"back_default,omitempty"`
-					BackShiny    string `json:"back_shiny,omitempty"`
-					FrontDefault string `json:"front_default,omitempty"`
-					FrontShiny   string `json:"front_shiny,omitempty"`
-				} `json:"gold,omitempty"`
-				Silver struct {
-					BackDefault  string `json:"back_default,omitempty"`
-					BackShiny    string `json:"back_shiny,omitempty"`
-					FrontDefault string `json:"front_default,omitempty"`
-					FrontShiny   string `json:"front_shiny,omitempty"`
-				} `json:"silver,omitempty"`
-			} `json:"generation-ii,omitempty"`
-			GenerationIii struct {
-				Emerald struct {
-					FrontDefault string `json:"front_default,omitempty"`
-					FrontShiny   string `json:"front_shiny,omitempty"`
-				} `json:"emerald,omitempty"`
-				FireredLeafgreen struct {
-					BackDefault  string `json:"back_default,omitempty"`
-					BackShiny    string `json:"back_shiny,omitempty"`
-					FrontDefault string `json:"front_default,omitempty"`
-					FrontShiny   string `json:"front_shiny,omitempty"`
-				} `json:"firered-leafgreen,omitempty"`
-				RubySapphire struct {
-					BackDefault  string `json:"back_default,omitempty"`
-					BackShiny    string `json:"back_shiny,omitempty"`
-					FrontDefault string `json:"front_default,omitempty"`
-					FrontShiny   string `json:"front_shiny,omitempty"`
-				} `json:"ruby-sapphire,omitempty"`
-			} `json:"generation-iii,omitempty"`
-			GenerationIv struct {
-				DiamondPearl struct {
-					BackDefault      string `json:"back_default,omitempty"`
-					BackFemale       any    `json:"back_female,omitempty"`
-					BackShiny        string `json:"back_shiny,omitempty"`
-					BackShinyFemale  any    `json:"back_shiny_female,omitempty"`
-					FrontDefault     string `json:"front_default,omitempty"`
-					FrontFemale      any    `json:"front_female,omitempty"`
-					FrontShiny       string `json:"front_shiny,omitempty"`
-					FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
-				} `json:"diamond-pearl,omitempty"`
-				HeartgoldSoulsilver struct {
-					BackDefault      string `json:"back_default,omitempty"`
-					BackFemale       any    `json:"back_female,omitempty"`
-					BackShiny        string `json:"back_shiny,omitempty"`
-					BackShinyFemale  any    `json:"back_shiny_female,omitempty"`
-					FrontDefault     string `json:"front_default,omitempty"`
-					FrontFemale      any    `json:"front_female,omitempty"`
-					FrontShiny       string `json:"front_shiny,omitempty"`
-					FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
-				} `json:"heartgold-soulsilver,omitempty"`
-				Platinum struct {
-					BackDefault      string `json:"back_default,omitempty"`
-					BackFemale       any    `json:"back_female,omitempty"`
-					BackShiny        string `json:"back_shiny,omitempty"`
-					BackShinyFemale  any    `json:"back_shiny_female,omitempty"`
-					FrontDefault     string `json:"front_default,omitempty"`
-					FrontFemale      any    `json:"front_female,omitempty"`
-					FrontShiny       string `json:"front_shiny,omitempty"`
-					FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
-				} `json:"platinum,omitempty"`
-			} `json:"generation-iv,omitempty"`
-			GenerationV struct {
-				BlackWhite struct {
-					Animated struct {
-						BackDefault      string `json:"back_default,omitempty"`
-						BackFemale       any    `json:"back_female,omitempty"`
-						BackShiny        string `json:"back_shiny,omitempty"`
-						BackShinyFemale  any    `json:"back_shiny_female,omitempty"`
-						FrontDefault     string `json:"front_default,omitempty"`
-						FrontFemale      any    `json:"front_female,omitempty"`
-						FrontShiny       string `json:"front_shiny,omitempty"`
-						FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
-					} `json:"animated,omitempty"`
-					BackDefault      string `json:"back_default,omitempty"`
-					BackFemale       any    `json:"back_female,omitempty"`
-					BackShiny        string `json:"back_shiny,omitempty"`
-					BackShinyFemale  any    `json:"back_shiny_female,omitempty"`
-					FrontDefault     string `json:"front_default,omitempty"`
-					FrontFemale      any    `json:"front_female,omitempty"`
-					FrontShiny       string `json:"front_shiny,omitempty"`
-					FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
-				} `json:"black-white,omitempty"`
-			} `json:"generation-v,omitempty"`
-			GenerationVi struct {
-				OmegarubyAlphasapphire struct {
-					FrontDefault     string `json:"front_default,omitempty"`
-					FrontFemale      any    `json:"front_female,omitempty"`
-					FrontShiny       string `json:"front_shiny,omitempty"`
-					FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
-				} `json:"omegaruby-alphasapphire,omitempty"`
-				XY struct {
-					FrontDefault     string `json:"front_default,omitempty"`
-					FrontFemale      any    `json:"front_female,omitempty"`
-					FrontShiny       string `json:"front_shiny,omitempty"`
-					FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
-				} `json:"x-y,omitempty"`
-			} `json:"generation-vi,omitempty"`
-			GenerationVii struct {
-				Icons struct {
-					FrontDefault string `json:"front_default,omitempty"`
-					FrontFemale  any    `json:"front_female,omitempty"`
-				} `json:"icons,omitempty"`
-				UltraSunUltraMoon struct {
-					FrontDefault     string `json:"front_default,omitempty"`
-					FrontFemale      any    `json:"front_female,omitempty"`
-					FrontShiny       string `json:"front_shiny,omitempty"`
-					FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
-				} `json:"ultra-sun-ultra-moon,omitempty"`
-			} `json:"generation-vii,omitempty"`
-			GenerationViii struct {
-				Icons struct {
-					FrontDefault string `json:"front_default,omitempty"`
-					FrontFemale  any    `json:"front_female,omitempty"`
-				} `json:"icons,omitempty"`
-			} `json:"generation-viii,omitempty"`
-		} `json:"versions,omitempty"`
-	} `json:"sprites,omitempty"`
-	Cries struct {
-		Latest string `json:"latest,omitempty"`
-		Legacy string `json:"legacy,omitempty"`
-	} `json:"cries,omitempty"`
-	Stats []struct {
-		BaseStat int `json:"base_stat,omitempty"`
-		Effort   int `json:"effort,omitempty"`
-		Stat     struct {
-			Name string `json:"name,omitempty"`
-			URL  string `json:"url,omitempty"`
-		} `json:"stat,omitempty"`
-	} `json:"stats,omitempty"`
-	Types []struct {
-		Slot int `json:"slot,omitempty"`
-		Type struct {
-			Name string `json:"name,omitempty"`
-			URL  string `json:"url,omitempty"`
-		} `json:"type,omitempty"`
-	} `json:"types,omitempty"`
-	PastTypes []struct {
-		Generation struct {
-			Name string `json:"name,omitempty"`
-			URL  string `json:"url,omitempty"`
-		} `json:"generation,omitempty"`
-		Types []struct {
-			Slot int `json:"slot,omitempty"`
-			Type struct {
-				Name string `json:"name,omitempty"`
-				URL  string `json:"url,omitempty"`
-			} `json:"type,omitempty"`
-		} `json:"types,omitempty"`
-	} `json:"past_types,omitempty"`
+// ballBonuses are the catch rate modifiers used when an item's effect text
+// does not state one.
+var ballBonuses = map[string]float64{
+	"poke-ball":   1,
+	"great-ball":  1.5,
+	"ultra-ball":  2,
+	"master-ball": masterBallBonus,
 }
 
 func commandCatch(cfg *config.Clicfg, args []string) error {
+	args, flags, err := parseFlags(args, []string{"ball"}, nil)
+	if err != nil {
+		return err
+	}
+	if len(args) <= 0 && cfg.Wild != nil {
+		args = []string{cfg.Wild.Name}
+	}
 	if len(args) <= 0 {
 		return errors.New("no pokemon argument given")
 	}
-	url := "https://pokeapi.co/api/v2/pokemon/" + args[0]
 
-	body, err := cfg.Cache.Get(url)
+	ball := "poke-ball"
+	if flags["ball"] != "" {
+		ball = strings.ToLower(flags["ball"])
+	}
+	if cfg.Inventory[ball] <= 0 {
+		return fmt.Errorf("no %s left in your bag, buy more with: shop buy %s", ball, ball)
+	}
+	item, err := cfg.API.GetItem(ball)
 	if err != nil {
-		resp, err := http.Get(url)
-		if err != nil {
-			return err
-		}
+		return err
+	}
+	bonus, err := ballBonus(item)
+	if err != nil {
+		return err
+	}
 
-		body, err = io.ReadAll(resp.Body)
-		resp.Body.Close()
-		if resp.StatusCode > 299 {
-			return errors.New("failed response")
-		}
-		if err != nil {
+	area, err := currentArea(cfg)
+	if err != nil {
+		return err
+	}
+
+	name, err := resolveName(cfg, "pokemon", args[0])
+	if err != nil {
+		return err
+	}
+	respData, err := cfg.API.GetPokemon(name)
+	if err != nil {
+		return err
+	}
+	enc, ok := area.Encounter(respData.Name)
+	if !ok {
+		return fmt.Errorf("%s does not live in %s", respData.Name, area.Name)
+	}
+
+	wild := cfg.Wild
+	if wild == nil || wild.Name != respData.Name || wild.Location != area.Name {
+		level, ok := rollAppearance(enc, rand.Intn)
+		if !ok {
+			fmt.Printf("You searched %s but %s did not show up...\n", area.Name, respData.Name)
 			return nil
 		}
-		defer cfg.Cache.Add(url, body)
+		wild = &config.WildPokemon{Name: respData.Name, Level: level, Location: area.Name}
+		fmt.Printf("A wild %s (Lv. %d) appeared!\n", wild.Name, wild.Level)
 	}
 
-	respData := pokemonData{}
-	err = json.Unmarshal(body, &respData)
+	species, err := cfg.API.GetPokemonSpecies(respData.Species.Name)
 	if err != nil {
 		return err
 	}
 
-	val := rand.Float64()
+	if err := cfg.UseItem(ball); err != nil {
+		return err
+	}
+
+	attempt := wildCaptureAttempt(hpAtLevel(respData.BaseStat("hp"), wild.Level), species.CaptureRate, bonus)
+	fmt.Printf("Throwing a %s at %s (HP %d/%d%s)...\n", ball, respData.Name, attempt.hp, attempt.maxHP, attempt.statusLabel)
 
-	if val >= 25.0/float64(respData.BaseExperience) {
-		fmt.Printf("%s was caught!\n", args[0])
-	} else {
-		fmt.Printf("%s escaped!\n", args[0])
+	shakes, caught := captureShakes(attempt.captureAttempt, rand.Intn)
+	for i := 0; i < shakes; i++ {
+		fmt.Println("  ...shake...")
 	}
 
-	cfg.AddPokemon(args[0])
+	if !caught {
+		fmt.Printf("%s broke free!\n", respData.Name)
+		return nil
+	}
+	fmt.Printf("%s was caught!\n", respData.Name)
+	if wild == cfg.Wild {
+		cfg.Wild = nil
+	}
+
+	p, box := cfg.Catch(respData.Name, wild.Level, area.Name)
+	config.RollGenes(p, rand.Intn)
+	p.Ability = rollAbility(respData, rand.Intn)
+	if growth, err := cfg.API.GetGrowthRate(species.GrowthRate.Name); err == nil {
+		p.Exp = growth.ExperienceAt(p.Level)
+	}
+	if box == 0 {
+		fmt.Printf("%s joined your party as #%d\n", p.Species, p.ID)
+	} else {
+		fmt.Printf("Your party is full, %s was sent to box %d as #%d\n", p.Species, box, p.ID)
+	}
 
 	return nil
 }
+
+// hpAtLevel is the max HP of a wild pokemon with average genes at level.
+func hpAtLevel(base, level int) int {
+	return (2*base+15)*level/100 + level + 10
+}
+
+type wildAttempt struct {
+	captureAttempt
+	statusLabel string
+}
+
+// wildCaptureAttempt simulates the state of the wild pokemon when the ball is
+// thrown: somewhere between full and 10% HP, and possibly with a status.
+func wildCaptureAttempt(maxHP, captureRate int, ballBonus float64) wildAttempt {
+	maxHP = max(maxHP, 1)
+	a := wildAttempt{
+		captureAttempt: captureAttempt{
+			captureRate: captureRate,
+			ballBonus:   ballBonus,
+			maxHP:       maxHP,
+			hp:          max(1, maxHP-rand.Intn(maxHP*9/10+1)),
+			statusBonus: 1,
+		},
+	}
+
+	if rand.Intn(3) == 0 {
+		s := captureStatuses[rand.Intn(len(captureStatuses))]
+		a.statusBonus = s.bonus
+		a.statusLabel = ", " + s.name
+	}
+
+	return a
+}
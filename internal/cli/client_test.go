@@ -0,0 +1,25 @@
+package cli
+
+import (
+	"errors"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+const testBaseURL = "http://stub/api/v2/"
+
+// mapFetcher serves canned responses keyed by path under testBaseURL.
+type mapFetcher map[string]string
+
+func (m mapFetcher) Get(url string) ([]byte, error) {
+	v, ok := m[url[len(testBaseURL):]]
+	if !ok {
+		return nil, errors.New("failed response")
+	}
+	return []byte(v), nil
+}
+
+func testCfg(responses mapFetcher) *config.Clicfg {
+	return &config.Clicfg{API: pokeapi.NewClient(testBaseURL, responses)}
+}
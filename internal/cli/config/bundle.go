@@ -0,0 +1,62 @@
+package config
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// bundle is a read-only set of pre-seeded responses used in offline mode.
+// It shares the disk cache layout, so a populated cache directory (or a zip
+// of one) can be used as a bundle as-is.
+type bundle interface {
+	read(rawURL string) ([]byte, error)
+	Close() error
+}
+
+// openBundle opens a bundle directory or a .zip archive of one.
+func openBundle(path string) (bundle, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return &diskStore{path}, nil
+	}
+	if strings.EqualFold(filepath.Ext(path), ".zip") {
+		r, err := zip.OpenReader(path)
+		if err != nil {
+			return nil, err
+		}
+		return &zipBundle{r}, nil
+	}
+	return nil, fmt.Errorf("bundle %s is neither a directory nor a .zip archive", path)
+}
+
+type zipBundle struct {
+	r *zip.ReadCloser
+}
+
+func (z *zipBundle) read(rawURL string) ([]byte, error) {
+	name, err := entryPath(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	f, err := z.r.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
+
+func (z *zipBundle) Close() error {
+	return z.r.Close()
+}
+
+// ErrNotBundled is returned in offline mode for responses the bundle lacks.
+var ErrNotBundled = errors.New("not in offline bundle")
@@ -0,0 +1,95 @@
+package config
+
+import (
+	"archive/zip"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const bundledURL = "https://pokeapi.co/api/v2/location-area/?offset=0&limit=20"
+
+func TestOfflineCacheDirBundle(t *testing.T) {
+	dir := t.TempDir()
+	d, err := newDiskStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.write(bundledURL, []byte("areas")); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := NewOfflineCache(time.Second, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := c.Get(bundledURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(v) != "areas" {
+		t.Fatalf("got %q from bundle, want %q", v, "areas")
+	}
+
+	_, err = c.Get("https://pokeapi.co/api/v2/pokemon/pikachu")
+	if !errors.Is(err, ErrNotBundled) {
+		t.Fatalf("expected not bundled error, got %v", err)
+	}
+}
+
+func TestOfflineCacheZipBundle(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bundle.zip")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, _ := entryPath(bundledURL)
+	zw := zip.NewWriter(f)
+	w, err := zw.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write([]byte("areas"))
+	zw.Close()
+	f.Close()
+
+	c, err := NewOfflineCache(time.Second, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := c.Get(bundledURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(v) != "areas" {
+		t.Fatalf("got %q from zip bundle, want %q", v, "areas")
+	}
+
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Close(); err == nil {
+		t.Fatal("zip bundle still open after close")
+	}
+}
+
+func TestOfflineCacheReadError(t *testing.T) {
+	dir := t.TempDir()
+	name, _ := entryPath(bundledURL)
+	// a directory where the entry should be cannot be read as one
+	if err := os.MkdirAll(filepath.Join(dir, filepath.FromSlash(name)), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := NewOfflineCache(time.Second, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	_, err = c.Get(bundledURL)
+	if err == nil || errors.Is(err, ErrNotBundled) {
+		t.Fatalf("expected the read error to pass through, got %v", err)
+	}
+}
@@ -2,8 +2,12 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"io"
+	"io/fs"
 	"net/http"
+	"os"
+	"path/filepath"
 	"sync"
 	"time"
 )
@@ -12,6 +16,12 @@ type Cache struct {
 	refreshInterval time.Duration
 	Entries         map[string]*cacheEntry
 	mu              *sync.Mutex
+	disk            *diskStore
+	offline         bundle
+
+	// OnDiskError is told when a response cannot be written to the disk
+	// cache. The entry is still kept in memory for the session.
+	OnDiskError func(error)
 }
 
 type cacheEntry struct {
@@ -20,11 +30,54 @@ type cacheEntry struct {
 }
 
 func NewCache(refreshInterval time.Duration) Cache {
-	c := Cache{refreshInterval, map[string]*cacheEntry{}, &sync.Mutex{}}
+	c := Cache{refreshInterval, map[string]*cacheEntry{}, &sync.Mutex{}, nil, nil, nil}
 	c.reapLoop(500 * time.Millisecond)
 	return c
 }
 
+// NewDiskCache returns a Cache that also persists every response under dir,
+// so entries reaped from memory or lost on exit are read back from disk
+// instead of being fetched again.
+func NewDiskCache(refreshInterval time.Duration, dir string) (Cache, error) {
+	d, err := newDiskStore(dir)
+	if err != nil {
+		return Cache{}, err
+	}
+	c := NewCache(refreshInterval)
+	c.disk = d
+	return c, nil
+}
+
+// NewOfflineCache returns a Cache that never touches the network and serves
+// everything it does not hold in memory from the bundle at bundlePath.
+func NewOfflineCache(refreshInterval time.Duration, bundlePath string) (Cache, error) {
+	b, err := openBundle(bundlePath)
+	if err != nil {
+		return Cache{}, err
+	}
+	c := NewCache(refreshInterval)
+	c.offline = b
+	return c, nil
+}
+
+// DefaultCacheDir is the directory used for the on-disk cache, located
+// under the user's cache directory.
+func DefaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "bd-pokedex"), nil
+}
+
+// Close releases the offline bundle, if the cache reads from one.
+func (c *Cache) Close() error {
+	if c.offline == nil {
+		return nil
+	}
+	return c.offline.Close()
+}
+
 func (c *Cache) reapLoop(checkInterval time.Duration) {
 	ticker := time.NewTicker(checkInterval)
 	check := func() {
@@ -43,6 +96,16 @@ func (c *Cache) reapLoop(checkInterval time.Duration) {
 }
 
 func (c *Cache) Add(s string, v []byte) {
+	c.store(s, v)
+	if c.disk == nil {
+		return
+	}
+	if err := c.disk.write(s, v); err != nil && c.OnDiskError != nil {
+		c.OnDiskError(err)
+	}
+}
+
+func (c *Cache) store(s string, v []byte) {
 	e := cacheEntry{
 		time.Now(),
 		v,
@@ -54,26 +117,44 @@ func (c *Cache) Add(s string, v []byte) {
 
 func (c *Cache) Get(url string) ([]byte, error) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
-
 	v, ok := c.Entries[url]
-	if !ok {
-		resp, err := http.Get(url)
-		if err != nil {
-			return nil, err
-		}
+	c.mu.Unlock()
+	if ok {
+		return v.val, nil
+	}
 
-		body, err := io.ReadAll(resp.Body)
-		resp.Body.Close()
+	if c.disk != nil {
+		if body, err := c.disk.read(url); err == nil {
+			c.store(url, body)
+			return body, nil
+		}
+	}
 
-		if resp.StatusCode > 299 {
-			return nil, errors.New("failed response")
+	if c.offline != nil {
+		body, err := c.offline.read(url)
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%s: %w", url, ErrNotBundled)
 		} else if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("%s: reading offline bundle: %w", url, err)
 		}
-
-		go c.Add(url, body)
+		c.store(url, body)
 		return body, nil
 	}
-	return v.val, nil
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return nil, errors.New("failed response")
+	} else if err != nil {
+		return nil, err
+	}
+
+	c.Add(url, body)
+	return body, nil
 }
@@ -0,0 +1,97 @@
+package config
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDiskCachePersists(t *testing.T) {
+	dir := t.TempDir()
+	url := "https://pokeapi.co/api/v2/pokemon/pikachu"
+
+	c, err := NewDiskCache(time.Second, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Add(url, []byte("pika"))
+
+	c2, err := NewDiskCache(time.Second, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := c2.Get(url)
+	if err != nil {
+		t.Fatal("new cache did not read entry from disk")
+	}
+	if string(v) != "pika" {
+		t.Fatalf("got %q from disk, want %q", v, "pika")
+	}
+}
+
+func TestDiskCacheStoresFetched(t *testing.T) {
+	dir := t.TempDir()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("area"))
+	}))
+	url := srv.URL + "/api/v2/location-area/?offset=0&limit=20"
+
+	c, err := NewDiskCache(time.Second, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get(url); err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+
+	c2, err := NewDiskCache(time.Second, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := c2.Get(url)
+	if err != nil {
+		t.Fatal("fetched entry was not persisted to disk")
+	}
+	if string(v) != "area" {
+		t.Fatalf("got %q from disk, want %q", v, "area")
+	}
+}
+
+func TestEntryPathStaysInStore(t *testing.T) {
+	name, err := entryPath("https://pokeapi.co/api/v2/pokemon/../../../../../etc/passwd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "pokeapi.co/etc/passwd.json" {
+		t.Fatalf("unexpected entry path %q", name)
+	}
+}
+
+func TestDiskCacheWriteError(t *testing.T) {
+	dir := t.TempDir()
+	url := "https://pokeapi.co/api/v2/pokemon/pikachu"
+
+	c, err := NewDiskCache(time.Second, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a file where the host directory should go makes every write fail
+	if err := os.WriteFile(filepath.Join(dir, "pokeapi.co"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var diskErr error
+	c.OnDiskError = func(err error) { diskErr = err }
+	c.Add(url, []byte("pika"))
+	if diskErr == nil {
+		t.Fatal("failed disk write not reported")
+	}
+
+	v, err := c.Get(url)
+	if err != nil || string(v) != "pika" {
+		t.Fatalf("entry not kept in memory: %q, %v", v, err)
+	}
+}
@@ -1,20 +1,75 @@
 package config
 
-import "slices"
+import (
+	"bufio"
+	"slices"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
 
 type Clicfg struct {
 	Cache         Cache
+	API           *pokeapi.Client
 	Commands      map[string]CliCommand
+	Input         *bufio.Scanner
 	CaughtPokemon []string
-	MapLast       *string
-	MapNext       *string
-	MapPrev       *string
+	Party         []*Pokemon
+	Boxes         [][]*Pokemon
+	NextPokemonID int
+	Inventory     map[string]int
+	Money         int
+	Location      string
+	Wild          *WildPokemon
+	Map           MapPosition
+	StatePath     string
+	Profile       string
+	ProfileDir    string
+	Language      string
+
+	// BattleTypes and TypeChart hold the type damage relations once
+	// fetched, they do not change within a session
+	BattleTypes []string
+	TypeChart   map[string]map[string]float64
+
+	// AreaNames are the location area names translated so far, keyed by
+	// language and area, each takes up to two fetches to look up
+	AreaNames map[string]string
+
+	// SearchIndex holds the pokemon indexed by searches so far, by name
+	SearchIndex map[string]SearchEntry
+}
+
+// WildPokemon is a pokemon met in an encounter, waiting to be caught.
+type WildPokemon struct {
+	Name     string
+	Level    int
+	Location string
+}
+
+// SearchEntry is the data searches match against for one pokemon.
+type SearchEntry struct {
+	ID        int
+	Name      string
+	Species   string
+	Types     []string
+	Abilities []string
+	Stats     map[string]int
+}
+
+// MapPosition is the page of location areas the map command showed last,
+// and whether the listing goes on after and before it.
+type MapPosition struct {
+	Offset  int  `json:"offset"`
+	Limit   int  `json:"limit"`
+	HasNext bool `json:"has_next,omitempty"`
+	HasPrev bool `json:"has_prev,omitempty"`
 }
 
 func NewClicfg() *Clicfg {
 	return &Clicfg{}
 }
 
+// AddPokemon registers species as caught in the pokedex.
 func (c *Clicfg) AddPokemon(pokemon string) {
 	if !slices.Contains(c.CaughtPokemon, pokemon) {
 		c.CaughtPokemon = append(c.CaughtPokemon, pokemon)
@@ -0,0 +1,83 @@
+package config
+
+import (
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// diskStore keeps cached responses as one JSON file per URL, laid out as
+// <dir>/<host>/<path>[@<query>].json.
+type diskStore struct {
+	dir string
+}
+
+func newDiskStore(dir string) (*diskStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &diskStore{dir}, nil
+}
+
+func (d *diskStore) read(rawURL string) ([]byte, error) {
+	name, err := entryPath(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(filepath.Join(d.dir, filepath.FromSlash(name)))
+}
+
+// Close is a no-op, files are only open while an entry is read or written.
+func (d *diskStore) Close() error {
+	return nil
+}
+
+func (d *diskStore) write(rawURL string, v []byte) error {
+	name, err := entryPath(rawURL)
+	if err != nil {
+		return err
+	}
+	p := filepath.Join(d.dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return err
+	}
+
+	// write to a temp file first so a crash never leaves a partial entry
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(v); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), p)
+}
+
+var unsafeChars = strings.NewReplacer("/", "_", "\\", "_", ":", "_", "*", "_", "?", "_", "\"", "_", "<", "_", ">", "_", "|", "_")
+
+// entryPath maps a URL to its slash-separated relative file name. Cleaning
+// the path from a rooted form keeps ".." segments from escaping the store.
+func entryPath(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+
+	p := strings.TrimPrefix(path.Clean("/"+u.Path), "/")
+	if p == "" {
+		p = "index"
+	}
+	name := path.Join(unsafeChars.Replace(u.Host), p)
+	if u.RawQuery != "" {
+		name += "@" + unsafeChars.Replace(u.RawQuery)
+	}
+	return name + ".json", nil
+}
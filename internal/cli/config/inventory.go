@@ -0,0 +1,42 @@
+package config
+
+import "fmt"
+
+// starting kit handed to new trainers
+const (
+	StartingMoney     = 3000
+	StartingPokeBalls = 5
+)
+
+func (c *Clicfg) AddItem(item string, n int) {
+	if c.Inventory == nil {
+		c.Inventory = map[string]int{}
+	}
+	c.Inventory[item] += n
+}
+
+// UseItem consumes one of item from the inventory.
+func (c *Clicfg) UseItem(item string) error {
+	if c.Inventory[item] <= 0 {
+		return fmt.Errorf("no %s left in your bag", item)
+	}
+	c.Inventory[item]--
+	if c.Inventory[item] == 0 {
+		delete(c.Inventory, item)
+	}
+	return nil
+}
+
+// Buy pays for n of item at cost each and adds them to the inventory.
+func (c *Clicfg) Buy(item string, cost, n int) error {
+	if n <= 0 || cost <= 0 {
+		return fmt.Errorf("cannot buy %d %s at $%d", n, item, cost)
+	}
+	// compare before multiplying, a large n would overflow the total
+	if n > c.Money/cost {
+		return fmt.Errorf("%d %s cost $%d each but you only have $%d", n, item, cost, c.Money)
+	}
+	c.Money -= cost * n
+	c.AddItem(item, n)
+	return nil
+}
@@ -0,0 +1,35 @@
+package config
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBuy(t *testing.T) {
+	c := &Clicfg{Money: 1000}
+	if err := c.Buy("poke-ball", 200, 3); err != nil {
+		t.Fatal(err)
+	}
+	if c.Money != 400 || c.Inventory["poke-ball"] != 3 {
+		t.Fatalf("got $%d and %d balls", c.Money, c.Inventory["poke-ball"])
+	}
+
+	if err := c.Buy("poke-ball", 200, 3); err == nil {
+		t.Fatal("expected an error when the trainer cannot afford it")
+	}
+	if err := c.Buy("poke-ball", 200, 0); err == nil {
+		t.Fatal("expected an error for a zero quantity")
+	}
+}
+
+func TestBuyOverflow(t *testing.T) {
+	c := &Clicfg{Money: 3000}
+	// 200 * n wraps around to a negative total
+	n := math.MaxInt/200 + 2
+	if err := c.Buy("poke-ball", 200, n); err == nil {
+		t.Fatal("expected an error for a quantity that overflows the total")
+	}
+	if c.Money != 3000 || c.Inventory["poke-ball"] != 0 {
+		t.Fatalf("failed purchase changed the bag: $%d, %d balls", c.Money, c.Inventory["poke-ball"])
+	}
+}
@@ -0,0 +1,193 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	PartySize = 6
+	BoxSize   = 30
+)
+
+// Pokemon is one caught pokemon.
+type Pokemon struct {
+	ID       int       `json:"id"`
+	Species  string    `json:"species"`
+	Level    int       `json:"level"`
+	Nickname string    `json:"nickname,omitempty"`
+	Location string    `json:"location,omitempty"`
+	CaughtAt time.Time `json:"caught_at"`
+	Exp      int       `json:"exp"`
+	IVs      Stats     `json:"ivs"`
+	EVs      Stats     `json:"evs"`
+	Nature   string    `json:"nature"`
+	Ability  string    `json:"ability,omitempty"`
+}
+
+// DisplayName is the nickname, or the species for pokemon without one.
+func (p *Pokemon) DisplayName() string {
+	if p.Nickname != "" {
+		return p.Nickname
+	}
+	return p.Species
+}
+
+func (p *Pokemon) String() string {
+	if p.Nickname != "" {
+		return fmt.Sprintf("#%d %s (%s) Lv. %d", p.ID, p.Nickname, p.Species, p.Level)
+	}
+	return fmt.Sprintf("#%d %s Lv. %d", p.ID, p.Species, p.Level)
+}
+
+// Catch registers species in the pokedex and stores a new pokemon for it,
+// in the party while there is room and otherwise in the first PC box with
+// space. It returns the pokemon and the box it went to, 0 for the party.
+func (c *Clicfg) Catch(species string, level int, location string) (*Pokemon, int) {
+	c.AddPokemon(species)
+
+	c.NextPokemonID++
+	p := &Pokemon{
+		ID:       c.NextPokemonID,
+		Species:  species,
+		Level:    level,
+		Location: location,
+		CaughtAt: time.Now(),
+	}
+
+	if len(c.Party) < PartySize {
+		c.Party = append(c.Party, p)
+		return p, 0
+	}
+	box := c.freeBox()
+	c.Boxes[box-1] = append(c.Boxes[box-1], p)
+	return p, box
+}
+
+// freeBox returns the first box with space, adding a new one if all are
+// full.
+func (c *Clicfg) freeBox() int {
+	for i := range c.Boxes {
+		if len(c.Boxes[i]) < BoxSize {
+			return i + 1
+		}
+	}
+	c.Boxes = append(c.Boxes, []*Pokemon{})
+	return len(c.Boxes)
+}
+
+// FindPokemon returns the caught pokemon with id and the box holding it,
+// 0 for the party.
+func (c *Clicfg) FindPokemon(id int) (*Pokemon, int, error) {
+	for _, p := range c.Party {
+		if p.ID == id {
+			return p, 0, nil
+		}
+	}
+	for i := range c.Boxes {
+		for _, p := range c.Boxes[i] {
+			if p.ID == id {
+				return p, i + 1, nil
+			}
+		}
+	}
+	return nil, 0, fmt.Errorf("you have no pokemon #%d", id)
+}
+
+// Caught returns every caught pokemon, party first and then box by box.
+func (c *Clicfg) Caught() []*Pokemon {
+	return allPokemon(c.Party, c.Boxes)
+}
+
+// Deposit moves a party pokemon into box, or the first box with space when
+// box is 0.
+func (c *Clicfg) Deposit(id int, box int) (int, error) {
+	p, from, err := c.FindPokemon(id)
+	if err != nil {
+		return 0, err
+	}
+	if from != 0 {
+		return 0, fmt.Errorf("%s is already in box %d", p.DisplayName(), from)
+	}
+	if len(c.Party) <= 1 {
+		return 0, errors.New("you cannot deposit your last party pokemon")
+	}
+
+	if box == 0 {
+		box = c.freeBox()
+	} else if box < 1 || box > len(c.Boxes)+1 {
+		return 0, fmt.Errorf("there is no box %d", box)
+	} else if box == len(c.Boxes)+1 {
+		c.Boxes = append(c.Boxes, []*Pokemon{})
+	}
+	if len(c.Boxes[box-1]) >= BoxSize {
+		return 0, fmt.Errorf("box %d is full", box)
+	}
+
+	c.Party = removePokemon(c.Party, p)
+	c.Boxes[box-1] = append(c.Boxes[box-1], p)
+	return box, nil
+}
+
+// Withdraw moves a boxed pokemon into the party.
+func (c *Clicfg) Withdraw(id int) error {
+	p, from, err := c.FindPokemon(id)
+	if err != nil {
+		return err
+	}
+	if from == 0 {
+		return fmt.Errorf("%s is already in your party", p.DisplayName())
+	}
+	if len(c.Party) >= PartySize {
+		return fmt.Errorf("your party is full, deposit a pokemon first")
+	}
+
+	c.Boxes[from-1] = removePokemon(c.Boxes[from-1], p)
+	c.Party = append(c.Party, p)
+	return nil
+}
+
+// MaxNicknameLength is the longest nickname a pokemon can be given.
+const MaxNicknameLength = 12
+
+// SetNickname names the pokemon with id, clearing its nickname when name is
+// empty.
+func (c *Clicfg) SetNickname(id int, name string) (*Pokemon, error) {
+	p, _, err := c.FindPokemon(id)
+	if err != nil {
+		return nil, err
+	}
+	if len([]rune(name)) > MaxNicknameLength {
+		return nil, fmt.Errorf("nicknames can be at most %d characters", MaxNicknameLength)
+	}
+	p.Nickname = name
+	return p, nil
+}
+
+// Release removes the pokemon with id from the party or its box for good.
+func (c *Clicfg) Release(id int) (*Pokemon, error) {
+	p, box, err := c.FindPokemon(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if box == 0 {
+		if len(c.Party) <= 1 {
+			return nil, errors.New("you cannot release your last party pokemon")
+		}
+		c.Party = removePokemon(c.Party, p)
+	} else {
+		c.Boxes[box-1] = removePokemon(c.Boxes[box-1], p)
+	}
+	return p, nil
+}
+
+func removePokemon(list []*Pokemon, p *Pokemon) []*Pokemon {
+	for i := range list {
+		if list[i] == p {
+			return append(list[:i:i], list[i+1:]...)
+		}
+	}
+	return list
+}
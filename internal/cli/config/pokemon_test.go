@@ -0,0 +1,97 @@
+package config
+
+import "testing"
+
+func TestCatchOverflowsToBox(t *testing.T) {
+	c := NewClicfg()
+	for i := 0; i < PartySize; i++ {
+		if _, box := c.Catch("pidgey", 3, "route-1-area"); box != 0 {
+			t.Fatalf("pokemon %d went to box %d with room in the party", i+1, box)
+		}
+	}
+
+	p, box := c.Catch("rattata", 2, "route-1-area")
+	if box != 1 {
+		t.Fatalf("seventh pokemon went to box %d, want 1", box)
+	}
+	if p.ID != PartySize+1 {
+		t.Fatalf("seventh pokemon got id %d", p.ID)
+	}
+	if len(c.CaughtPokemon) != 2 {
+		t.Fatalf("pokedex has %v, want pidgey and rattata", c.CaughtPokemon)
+	}
+}
+
+func TestDepositWithdraw(t *testing.T) {
+	c := NewClicfg()
+	a, _ := c.Catch("pidgey", 3, "")
+	b, _ := c.Catch("rattata", 2, "")
+
+	box, err := c.Deposit(a.ID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if box != 1 || len(c.Party) != 1 || len(c.Boxes[0]) != 1 {
+		t.Fatal("deposit did not move pokemon to box 1")
+	}
+
+	if _, err := c.Deposit(b.ID, 0); err == nil {
+		t.Fatal("expected error depositing the last party pokemon")
+	}
+
+	if err := c.Withdraw(a.ID); err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Party) != 2 || len(c.Boxes[0]) != 0 {
+		t.Fatal("withdraw did not move pokemon back to the party")
+	}
+
+	if err := c.Withdraw(a.ID); err == nil {
+		t.Fatal("expected error withdrawing a party pokemon")
+	}
+}
+
+func TestRelease(t *testing.T) {
+	c := NewClicfg()
+	a, _ := c.Catch("pidgey", 3, "")
+	b, _ := c.Catch("rattata", 2, "")
+
+	if _, err := c.Release(a.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := c.FindPokemon(a.ID); err == nil {
+		t.Fatal("released pokemon is still owned")
+	}
+	if _, err := c.Release(b.ID); err == nil {
+		t.Fatal("expected error releasing the last party pokemon")
+	}
+}
+
+func TestGainEVsCaps(t *testing.T) {
+	p := &Pokemon{}
+	for i := 0; i < 100; i++ {
+		p.GainEVs("speed", 3)
+	}
+	if p.EVs.Speed != MaxStatEV {
+		t.Fatalf("speed EVs %d, want cap %d", p.EVs.Speed, MaxStatEV)
+	}
+
+	for i := 0; i < 100; i++ {
+		p.GainEVs("attack", 3)
+		p.GainEVs("defense", 3)
+	}
+	if p.EVs.Total() != MaxTotalEV {
+		t.Fatalf("total EVs %d, want cap %d", p.EVs.Total(), MaxTotalEV)
+	}
+}
+
+func TestRollGenes(t *testing.T) {
+	p := &Pokemon{}
+	RollGenes(p, func(n int) int { return n - 1 })
+	if p.IVs.Total() != 6*MaxIV {
+		t.Fatalf("max rolls gave IVs %+v", p.IVs)
+	}
+	if p.Nature == "" {
+		t.Fatal("no nature rolled")
+	}
+}
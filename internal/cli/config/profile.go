@@ -0,0 +1,78 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const DefaultProfile = "default"
+
+var profileNameRe = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// DefaultProfileDir is the directory holding one save file per profile.
+func DefaultProfileDir() (string, error) {
+	dir, err := DefaultConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "profiles"), nil
+}
+
+func ValidateProfileName(name string) error {
+	if !profileNameRe.MatchString(name) {
+		return fmt.Errorf("invalid profile name %q, use letters, digits, - and _", name)
+	}
+	return nil
+}
+
+func ProfilePath(dir, name string) string {
+	return filepath.Join(dir, name+".json")
+}
+
+// ListProfiles returns the sorted names of the profiles saved in dir.
+func ListProfiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return []string{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), ".json")
+		if ok && !e.IsDir() && profileNameRe.MatchString(name) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+// MigrateLegacySave moves the single save file used before profiles existed
+// into dir as the default profile, unless that profile already exists.
+func MigrateLegacySave(dir string) error {
+	cfgDir, err := DefaultConfigDir()
+	if err != nil {
+		return nil
+	}
+	legacy := filepath.Join(cfgDir, "save.json")
+	target := ProfilePath(dir, DefaultProfile)
+
+	if _, err := os.Stat(legacy); err != nil {
+		return nil
+	}
+	if _, err := os.Stat(target); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	return os.Rename(legacy, target)
+}
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestListProfiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"misty.json", "ash.json", "notes.txt", "bad name.json"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("{}"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	names, err := ListProfiles(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(names, []string{"ash", "misty"}) {
+		t.Fatalf("unexpected profiles %v", names)
+	}
+}
+
+func TestValidateProfileName(t *testing.T) {
+	if err := ValidateProfileName("ash-2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidateProfileName("../ash"); err == nil {
+		t.Fatal("expected path-like profile name to be rejected")
+	}
+}
@@ -0,0 +1,45 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Settings are the user preferences read from the config file. Empty
+// fields mean "use the default".
+type Settings struct {
+	BaseURL  string `json:"base_url,omitempty"`
+	Offline  bool   `json:"offline,omitempty"`
+	Bundle   string `json:"bundle,omitempty"`
+	Profile  string `json:"profile,omitempty"`
+	Language string `json:"language,omitempty"`
+}
+
+// DefaultConfigDir is the directory holding the config file, located under
+// the user's config directory.
+func DefaultConfigDir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "bd-pokedex"), nil
+}
+
+// LoadSettings reads the JSON config file at path. A missing file is not an
+// error and yields empty Settings.
+func LoadSettings(path string) (Settings, error) {
+	s := Settings{}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	} else if err != nil {
+		return s, err
+	}
+
+	err = json.Unmarshal(data, &s)
+	return s, err
+}
@@ -0,0 +1,177 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+// StateVersion is the current save file format. Bump it whenever State
+// changes shape and teach migrateState how to upgrade older files.
+const StateVersion = 7
+
+// State is the trainer progress carried across sessions.
+type State struct {
+	Version       int            `json:"version"`
+	CaughtPokemon []string       `json:"caught_pokemon"`
+	Party         []*Pokemon     `json:"party"`
+	Boxes         [][]*Pokemon   `json:"boxes"`
+	NextPokemonID int            `json:"next_pokemon_id"`
+	Inventory     map[string]int `json:"inventory"`
+	Money         int            `json:"money"`
+	Location      string         `json:"location,omitempty"`
+	Map           MapPosition    `json:"map"`
+
+	// MapLast, MapNext and MapPrev are the page URLs saved before version
+	// 7, only read to migrate older files.
+	MapLast *string `json:"map_last,omitempty"`
+	MapNext *string `json:"map_next,omitempty"`
+	MapPrev *string `json:"map_prev,omitempty"`
+}
+
+func (c *Clicfg) State() State {
+	return State{
+		Version:       StateVersion,
+		CaughtPokemon: c.CaughtPokemon,
+		Party:         c.Party,
+		Boxes:         c.Boxes,
+		NextPokemonID: c.NextPokemonID,
+		Inventory:     c.Inventory,
+		Money:         c.Money,
+		Location:      c.Location,
+		Map:           c.Map,
+	}
+}
+
+func (c *Clicfg) ApplyState(s State) {
+	c.CaughtPokemon = s.CaughtPokemon
+	if c.CaughtPokemon == nil {
+		c.CaughtPokemon = []string{}
+	}
+	c.Party = s.Party
+	c.Boxes = s.Boxes
+	c.NextPokemonID = s.NextPokemonID
+	c.Inventory = s.Inventory
+	if c.Inventory == nil {
+		c.Inventory = map[string]int{}
+	}
+	c.Money = s.Money
+	c.Location = s.Location
+	c.Map = s.Map
+	if c.Map.Limit <= 0 {
+		c.Map = MapPosition{Limit: pokeapi.DefaultPageSize}
+	}
+}
+
+func SaveState(path string, s State) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// AbilityRoller picks an ability for a pokemon of species. Migrations use
+// it to give pokemon from older saves one, which needs the species data.
+type AbilityRoller func(species string) (string, error)
+
+func LoadState(path string, rollAbility AbilityRoller) (State, error) {
+	s := State{}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return s, err
+	}
+	if err := json.Unmarshal(data, &s); err != nil {
+		return s, err
+	}
+
+	err = migrateState(&s, rollAbility)
+	return s, err
+}
+
+// migrateState upgrades s in place to StateVersion.
+func migrateState(s *State, rollAbility AbilityRoller) error {
+	if s.Version > StateVersion {
+		return fmt.Errorf("save file version %d is newer than supported version %d", s.Version, StateVersion)
+	}
+	if s.Version == 0 {
+		// files written before versioning are identical to version 1
+		s.Version = 1
+	}
+	if s.Version == 1 {
+		// version 2 added the inventory, give existing trainers the starting kit
+		s.Inventory = map[string]int{"poke-ball": StartingPokeBalls}
+		s.Money = StartingMoney
+		s.Version = 2
+	}
+	if s.Version == 2 {
+		// version 3 added the current location, trainers start nowhere
+		s.Version = 3
+	}
+	if s.Version == 3 {
+		// version 4 stores individual pokemon, give each registered species one
+		c := Clicfg{}
+		for _, species := range s.CaughtPokemon {
+			p, _ := c.Catch(species, 5, "")
+			p.CaughtAt = time.Time{}
+		}
+		s.Party, s.Boxes, s.NextPokemonID = c.Party, c.Boxes, c.NextPokemonID
+		s.Version = 4
+	}
+	if s.Version == 4 {
+		// version 5 added genes and experience; experience is topped up to
+		// the pokemon's level the first time it gains any
+		for _, p := range allPokemon(s.Party, s.Boxes) {
+			RollGenes(p, rand.Intn)
+		}
+		s.Version = 5
+	}
+	if s.Version == 5 {
+		// version 6 added abilities; pokemon whose species cannot be
+		// fetched, when offline for example, are left without one
+		for _, p := range allPokemon(s.Party, s.Boxes) {
+			if rollAbility == nil {
+				continue
+			}
+			if ability, err := rollAbility(p.Species); err == nil {
+				p.Ability = ability
+			}
+		}
+		s.Version = 6
+	}
+	if s.Version == 6 {
+		// version 7 saves the map position instead of page URLs, which
+		// pointed at whatever base URL was configured at the time
+		s.Map = MapPosition{Limit: pokeapi.DefaultPageSize}
+		if s.MapLast != nil {
+			s.Map.Offset, s.Map.Limit = pokeapi.PageOf(*s.MapLast)
+		}
+		s.Map.HasNext = s.MapNext != nil
+		s.Map.HasPrev = s.MapPrev != nil
+		s.MapLast, s.MapNext, s.MapPrev = nil, nil, nil
+		s.Version = 7
+	}
+	return nil
+}
+
+func allPokemon(party []*Pokemon, boxes [][]*Pokemon) []*Pokemon {
+	all := append([]*Pokemon{}, party...)
+	for i := range boxes {
+		all = append(all, boxes[i]...)
+	}
+	return all
+}
@@ -0,0 +1,123 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestStateRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+	c := NewClicfg()
+	c.AddPokemon("pikachu")
+	c.Map = MapPosition{Offset: 40, Limit: 20, HasNext: true}
+
+	if err := SaveState(path, c.State()); err != nil {
+		t.Fatal(err)
+	}
+	s, err := LoadState(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c2 := NewClicfg()
+	c2.ApplyState(s)
+	if !slices.Equal(c2.CaughtPokemon, []string{"pikachu"}) {
+		t.Fatalf("caught pokemon not restored, got %v", c2.CaughtPokemon)
+	}
+	if c2.Map != c.Map {
+		t.Fatalf("map position not restored, got %+v", c2.Map)
+	}
+}
+
+func TestLoadStateNewerVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+	if err := os.WriteFile(path, []byte(`{"version":999}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := LoadState(path, nil); err == nil {
+		t.Fatal("expected error loading a newer save version")
+	}
+}
+
+func TestLoadStateMigratesInventory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+	if err := os.WriteFile(path, []byte(`{"version":1,"caught_pokemon":["pidgey"]}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := LoadState(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Version != StateVersion {
+		t.Fatalf("state not migrated to version %d, got %d", StateVersion, s.Version)
+	}
+	if s.Inventory["poke-ball"] != StartingPokeBalls || s.Money != StartingMoney {
+		t.Fatal("migrated state missing starting kit")
+	}
+}
+
+func TestLoadStateMigratesCaughtPokemon(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+	err := os.WriteFile(path, []byte(`{"version":3,"caught_pokemon":["pidgey","pikachu"]}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := LoadState(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Party) != 2 || s.Party[1].Species != "pikachu" || s.NextPokemonID != 2 {
+		t.Fatal("caught species not migrated to party pokemon")
+	}
+}
+
+func TestLoadStateMigratesMapURLs(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+	save := `{"version":6,"map_last":"http://old-mirror/api/v2/location-area/?offset=40&limit=10","map_prev":"http://old-mirror/api/v2/location-area/?offset=30&limit=10"}`
+	if err := os.WriteFile(path, []byte(save), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := LoadState(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := MapPosition{Offset: 40, Limit: 10, HasPrev: true}
+	if s.Map != want {
+		t.Fatalf("got map position %+v, want %+v", s.Map, want)
+	}
+	if s.MapLast != nil || s.MapPrev != nil {
+		t.Fatal("old page URLs kept after migrating")
+	}
+}
+
+func TestLoadStateMigratesAbilities(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+	save := `{"version":5,"party":[{"id":1,"species":"pikachu"},{"id":2,"species":"missingno"}]}`
+	if err := os.WriteFile(path, []byte(save), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	roll := func(species string) (string, error) {
+		if species != "pikachu" {
+			return "", errors.New("failed response")
+		}
+		return "static", nil
+	}
+	s, err := LoadState(path, roll)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Party[0].Ability != "static" {
+		t.Fatalf("got ability %q", s.Party[0].Ability)
+	}
+	if s.Party[1].Ability != "" {
+		t.Fatalf("got ability %q for an unknown species", s.Party[1].Ability)
+	}
+}
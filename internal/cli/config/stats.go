@@ -0,0 +1,76 @@
+package config
+
+import "github.com/Quorum-Code/bd-pokedex/internal/nature"
+
+// StatNames are the PokeAPI names of the six stats.
+var StatNames = []string{"hp", "attack", "defense", "special-attack", "special-defense", "speed"}
+
+const (
+	MaxIV      = 31
+	MaxStatEV  = 252
+	MaxTotalEV = 510
+)
+
+// Stats holds one value per stat, used for IVs and EVs.
+type Stats struct {
+	HP             int `json:"hp"`
+	Attack         int `json:"attack"`
+	Defense        int `json:"defense"`
+	SpecialAttack  int `json:"special-attack"`
+	SpecialDefense int `json:"special-defense"`
+	Speed          int `json:"speed"`
+}
+
+func (s *Stats) field(name string) *int {
+	switch name {
+	case "hp":
+		return &s.HP
+	case "attack":
+		return &s.Attack
+	case "defense":
+		return &s.Defense
+	case "special-attack":
+		return &s.SpecialAttack
+	case "special-defense":
+		return &s.SpecialDefense
+	case "speed":
+		return &s.Speed
+	}
+	return nil
+}
+
+// Get returns the value of the named stat, 0 for unknown names.
+func (s *Stats) Get(name string) int {
+	if f := s.field(name); f != nil {
+		return *f
+	}
+	return 0
+}
+
+func (s *Stats) Set(name string, v int) {
+	if f := s.field(name); f != nil {
+		*f = v
+	}
+}
+
+func (s *Stats) Total() int {
+	return s.HP + s.Attack + s.Defense + s.SpecialAttack + s.SpecialDefense + s.Speed
+}
+
+// RollGenes gives p random IVs and a random nature. roll returns a random
+// int in [0, n).
+func RollGenes(p *Pokemon, roll func(n int) int) {
+	for _, name := range StatNames {
+		p.IVs.Set(name, roll(MaxIV+1))
+	}
+	natures := nature.Names()
+	p.Nature = natures[roll(len(natures))]
+}
+
+// GainEVs adds effort values to p, respecting the per-stat and total caps.
+func (p *Pokemon) GainEVs(name string, n int) {
+	n = min(n, MaxStatEV-p.EVs.Get(name), MaxTotalEV-p.EVs.Total())
+	if n > 0 {
+		p.EVs.Set(name, p.EVs.Get(name)+n)
+	}
+}
@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+func commandDex(cfg *config.Clicfg, args []string) error {
+	args, flags, err := parseFlags(args, []string{"version"}, nil)
+	if err != nil {
+		return err
+	}
+	if len(args) <= 0 {
+		return errors.New("no pokemon argument given")
+	}
+
+	poke, err := cfg.API.GetPokemon(args[0])
+	if err != nil {
+		return err
+	}
+	species, err := cfg.API.GetPokemonSpecies(poke.Species.Name)
+	if err != nil {
+		return err
+	}
+
+	name := species.Name
+	if localized(cfg) {
+		name = withName(localName(cfg, species.Names), species.Name)
+	}
+	genus := species.Genus(cfg.Language)
+	if genus == "" {
+		genus = species.Genus("en")
+	}
+	if genus != "" {
+		fmt.Printf("#%d %s, the %s\n", species.ID, name, genus)
+	} else {
+		fmt.Printf("#%d %s\n", species.ID, name)
+	}
+	fmt.Printf("Generation: %s\n", species.Generation.Name)
+	fmt.Printf("Color: %s\n", species.Color.Name)
+	fmt.Printf("Shape: %s\n", optionalName(species.Shape))
+	fmt.Printf("Habitat: %s\n", optionalName(species.Habitat))
+	eggGroups := []string{}
+	for _, g := range species.EggGroups {
+		eggGroups = append(eggGroups, g.Name)
+	}
+	fmt.Printf("Egg groups: %s\n", strings.Join(eggGroups, ", "))
+	fmt.Printf("Capture rate: %d\n", species.CaptureRate)
+	fmt.Printf("Legendary: %t\n", species.IsLegendary)
+	fmt.Printf("Mythical: %t\n", species.IsMythical)
+
+	version := strings.ToLower(flags["version"])
+	entries := []pokeapi.FlavorText{}
+	texts := species.FlavorTexts(cfg.Language)
+	if len(texts) <= 0 {
+		texts = species.FlavorTexts("en")
+	}
+	for _, f := range texts {
+		if version == "" || f.Version.Name == version {
+			entries = append(entries, f)
+		}
+	}
+	if len(entries) <= 0 {
+		if version != "" {
+			fmt.Printf("No pokedex entry for %s\n", version)
+		}
+		return nil
+	}
+
+	fmt.Printf("Entries:\n")
+	for _, e := range groupFlavorTexts(entries) {
+		fmt.Printf("  %s: %s\n", strings.Join(e.versions, ", "), e.text)
+	}
+
+	return nil
+}
+
+type flavorGroup struct {
+	text     string
+	versions []string
+}
+
+// groupFlavorTexts merges versions that share the same entry, as paired
+// games like red and blue mostly do, keeping the order entries first appear.
+func groupFlavorTexts(entries []pokeapi.FlavorText) []flavorGroup {
+	groups := []flavorGroup{}
+	index := map[string]int{}
+	for _, f := range entries {
+		text := f.Text()
+		i, ok := index[text]
+		if !ok {
+			i = len(groups)
+			index[text] = i
+			groups = append(groups, flavorGroup{text: text})
+		}
+		groups[i].versions = append(groups[i].versions, f.Version.Name)
+	}
+	return groups
+}
+
+func optionalName(r *pokeapi.NamedAPIResource) string {
+	if r == nil {
+		return "unknown"
+	}
+	return r.Name
+}
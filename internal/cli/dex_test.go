@@ -0,0 +1,31 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+func TestGroupFlavorTexts(t *testing.T) {
+	entry := func(text, version string) pokeapi.FlavorText {
+		return pokeapi.FlavorText{FlavorText: text, Version: pokeapi.NamedAPIResource{Name: version}}
+	}
+	groups := groupFlavorTexts([]pokeapi.FlavorText{
+		entry("It keeps its tail\nraised.", "red"),
+		entry("Stores electricity.", "yellow"),
+		entry("It keeps its tail\fraised.", "blue"),
+	})
+
+	if len(groups) != 2 {
+		t.Fatalf("got %d groups, want 2", len(groups))
+	}
+	if groups[0].text != "It keeps its tail raised." {
+		t.Fatalf("got %q", groups[0].text)
+	}
+	if len(groups[0].versions) != 2 || groups[0].versions[1] != "blue" {
+		t.Fatalf("got versions %v", groups[0].versions)
+	}
+	if groups[1].versions[0] != "yellow" {
+		t.Fatalf("got versions %v", groups[1].versions)
+	}
+}
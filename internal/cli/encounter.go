@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+// encounterSteps is how long the trainer searches before giving up.
+const encounterSteps = 20
+
+func commandEncounter(cfg *config.Clicfg, args []string) error {
+	args, flags, err := parseFlags(args, []string{"method", "version"}, nil)
+	if err != nil {
+		return err
+	}
+
+	area, err := currentArea(cfg)
+	if err != nil {
+		return err
+	}
+
+	method := "walk"
+	if flags["method"] != "" {
+		method = strings.ToLower(flags["method"])
+	}
+	rates := methodRates(area, method)
+	if len(rates) <= 0 {
+		return fmt.Errorf("cannot %s in %s", method, area.Name)
+	}
+
+	version := strings.ToLower(flags["version"])
+	if version == "" {
+		version = rates[0].Version.Name
+	}
+	rate := -1
+	for _, r := range rates {
+		if r.Version.Name == version {
+			rate = r.Rate
+		}
+	}
+	if rate < 0 {
+		return fmt.Errorf("cannot %s in %s in pokemon %s", method, area.Name, version)
+	}
+
+	for step := 1; step <= encounterSteps; step++ {
+		if rand.Intn(100) >= rate {
+			continue
+		}
+
+		name, level, ok := pickEncounter(area, method, version, rand.Intn)
+		if !ok {
+			break
+		}
+		cfg.Wild = &config.WildPokemon{Name: name, Level: level, Location: area.Name}
+		fmt.Printf("A wild %s (Lv. %d) appeared after %d steps!\n", name, level, step)
+		fmt.Printf("Catch it with: catch %s\n", name)
+		return nil
+	}
+
+	fmt.Printf("You searched %s for a while but nothing appeared...\n", area.Name)
+	return nil
+}
+
+// methodRates returns the per-version encounter rates of method in area.
+func methodRates(area pokeapi.LocationArea, method string) []pokeapi.EncounterVersionRate {
+	for _, m := range area.EncounterMethodRates {
+		if m.EncounterMethod.Name == method {
+			return m.VersionDetails
+		}
+	}
+	return nil
+}
+
+// pickEncounter chooses the wild pokemon met by method in version, weighted
+// by the chance of each encounter slot, and rolls its level.
+func pickEncounter(area pokeapi.LocationArea, method, version string, roll func(n int) int) (string, int, bool) {
+	slots := encounterRows(area, version, method)
+	total := 0
+	for _, s := range slots {
+		total += max(s.Chance, 0)
+	}
+	if total <= 0 {
+		return "", 0, false
+	}
+
+	r := roll(total)
+	for _, s := range slots {
+		if s.Chance <= 0 {
+			continue
+		}
+		if r < s.Chance {
+			return s.pokemon, rollLevel(s.MinLevel, s.MaxLevel, roll), true
+		}
+		r -= s.Chance
+	}
+	return "", 0, false
+}
+
+type encounterRow struct {
+	pokemon string
+	version string
+	pokeapi.Encounter
+}
+
+// encounterRows flattens the area's encounter slots, keeping only those of
+// version and method when they are not empty.
+func encounterRows(area pokeapi.LocationArea, version, method string) []encounterRow {
+	rows := []encounterRow{}
+	for _, pe := range area.PokemonEncounters {
+		for _, vd := range pe.VersionDetails {
+			if version != "" && vd.Version.Name != version {
+				continue
+			}
+			for _, e := range vd.EncounterDetails {
+				if method != "" && e.Method.Name != method {
+					continue
+				}
+				rows = append(rows, encounterRow{pe.Pokemon.Name, vd.Version.Name, e})
+			}
+		}
+	}
+	return rows
+}
+
+func levelRange(minLevel, maxLevel int) string {
+	if maxLevel <= minLevel {
+		return fmt.Sprintf("%d", minLevel)
+	}
+	return fmt.Sprintf("%d-%d", minLevel, maxLevel)
+}
@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+func testArea() pokeapi.LocationArea {
+	walk := pokeapi.NamedAPIResource{Name: "walk"}
+	red := pokeapi.NamedAPIResource{Name: "red"}
+	return pokeapi.LocationArea{
+		Name: "viridian-forest-area",
+		PokemonEncounters: []pokeapi.PokemonEncounter{
+			{
+				Pokemon: pokeapi.NamedAPIResource{Name: "pikachu"},
+				VersionDetails: []pokeapi.VersionEncounterDetail{{
+					Version:          red,
+					EncounterDetails: []pokeapi.Encounter{{Chance: 5, MinLevel: 3, MaxLevel: 5, Method: walk}},
+				}},
+			},
+			{
+				Pokemon: pokeapi.NamedAPIResource{Name: "pidgey"},
+				VersionDetails: []pokeapi.VersionEncounterDetail{{
+					Version:          red,
+					EncounterDetails: []pokeapi.Encounter{{Chance: 45, MinLevel: 4, MaxLevel: 4, Method: walk}},
+				}, {
+					Version:          pokeapi.NamedAPIResource{Name: "blue"},
+					EncounterDetails: []pokeapi.Encounter{{Chance: 10, MinLevel: 20, MaxLevel: 25, Method: pokeapi.NamedAPIResource{Name: "surf"}}},
+				}},
+			},
+			// listed without any encounter details
+			{Pokemon: pokeapi.NamedAPIResource{Name: "caterpie"}},
+		},
+	}
+}
+
+func TestPickEncounter(t *testing.T) {
+	area := testArea()
+
+	name, level, ok := pickEncounter(area, "walk", "red", func(n int) int { return 0 })
+	if !ok || name != "pikachu" || level != 3 {
+		t.Fatalf("lowest roll picked %s Lv. %d", name, level)
+	}
+
+	name, level, ok = pickEncounter(area, "walk", "red", func(n int) int { return n - 1 })
+	if !ok || name != "pidgey" || level != 4 {
+		t.Fatalf("highest roll picked %s Lv. %d", name, level)
+	}
+
+	if _, _, ok := pickEncounter(area, "surf", "red", func(n int) int { return 0 }); ok {
+		t.Fatal("picked an encounter for a method the area does not have")
+	}
+}
+
+func TestRollAppearance(t *testing.T) {
+	enc, _ := testArea().Encounter("pikachu")
+
+	if _, ok := rollAppearance(enc, func(n int) int { return min(4, n-1) }); !ok {
+		t.Fatal("roll within the 5% chance should appear")
+	}
+	if _, ok := rollAppearance(enc, func(n int) int { return n - 1 }); ok {
+		t.Fatal("roll outside the 5% chance should not appear")
+	}
+}
+
+func TestEncounterRows(t *testing.T) {
+	area := testArea()
+
+	cases := []struct {
+		version, method string
+		want            []string
+	}{
+		{"", "", []string{"pikachu", "pidgey", "pidgey"}},
+		{"red", "", []string{"pikachu", "pidgey"}},
+		{"", "surf", []string{"pidgey"}},
+		{"blue", "walk", []string{}},
+	}
+	for _, c := range cases {
+		got := []string{}
+		for _, r := range encounterRows(area, c.version, c.method) {
+			got = append(got, r.pokemon)
+		}
+		if !slices.Equal(got, c.want) {
+			t.Fatalf("version %q method %q: got %v, want %v", c.version, c.method, got, c.want)
+		}
+	}
+
+	rows := encounterRows(area, "blue", "surf")
+	if rows[0].version != "blue" || rows[0].Chance != 10 || levelRange(rows[0].MinLevel, rows[0].MaxLevel) != "20-25" {
+		t.Fatalf("got %+v", rows[0])
+	}
+}
+
+func TestExplorePokemon(t *testing.T) {
+	area := testArea()
+
+	rows := encounterRows(area, "", "")
+	if names := explorePokemon(area, rows, false); !slices.Equal(names, []string{"pikachu", "pidgey", "caterpie"}) {
+		t.Fatalf("unfiltered explore dropped pokemon: %v", names)
+	}
+	if names := pokemonWithoutRows(area, rows); !slices.Equal(names, []string{"caterpie"}) {
+		t.Fatalf("got %v", names)
+	}
+
+	rows = encounterRows(area, "", "surf")
+	if names := explorePokemon(area, rows, true); !slices.Equal(names, []string{"pidgey"}) {
+		t.Fatalf("got %v", names)
+	}
+}
+
+func TestLevelRange(t *testing.T) {
+	if s := levelRange(4, 4); s != "4" {
+		t.Fatalf("got %q", s)
+	}
+	if s := levelRange(3, 5); s != "3-5" {
+		t.Fatalf("got %q", s)
+	}
+}
@@ -0,0 +1,279 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+func commandEvolution(cfg *config.Clicfg, args []string) error {
+	if len(args) <= 0 || args[0] == "" {
+		return errors.New("no pokemon argument given")
+	}
+
+	chain, err := evolutionChain(cfg, args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(chain.Chain.Species.Name)
+	printEvolutions(chain.Chain, "")
+
+	return nil
+}
+
+// printEvolutions draws the species l evolves into as a tree below it.
+func printEvolutions(l pokeapi.ChainLink, indent string) {
+	for i, next := range l.EvolvesTo {
+		branch, child := "├─ ", "│  "
+		if i == len(l.EvolvesTo)-1 {
+			branch, child = "└─ ", "   "
+		}
+
+		triggers := []string{}
+		for _, d := range next.EvolutionDetails {
+			triggers = append(triggers, describeEvolution(d))
+		}
+		fmt.Printf("%s%s%s (%s)\n", indent, branch, next.Species.Name, strings.Join(triggers, " or "))
+
+		printEvolutions(next, indent+child)
+	}
+}
+
+// describeEvolution renders the trigger and conditions of d.
+func describeEvolution(d pokeapi.EvolutionDetail) string {
+	parts := []string{}
+	switch d.Trigger.Name {
+	case "level-up":
+		if d.MinLevel != nil {
+			parts = append(parts, fmt.Sprintf("level %d", *d.MinLevel))
+		} else {
+			parts = append(parts, "level up")
+		}
+	case "use-item":
+		if d.Item != nil {
+			parts = append(parts, "use "+d.Item.Name)
+		} else {
+			parts = append(parts, "use item")
+		}
+	case "trade":
+		parts = append(parts, "trade")
+	default:
+		parts = append(parts, strings.ReplaceAll(d.Trigger.Name, "-", " "))
+	}
+
+	if d.Trigger.Name != "use-item" && d.Item != nil {
+		parts = append(parts, "using "+d.Item.Name)
+	}
+	if d.HeldItem != nil {
+		parts = append(parts, "holding "+d.HeldItem.Name)
+	}
+	if d.TradeSpecies != nil {
+		parts = append(parts, "for "+d.TradeSpecies.Name)
+	}
+	if d.MinHappiness != nil {
+		parts = append(parts, "with high friendship")
+	}
+	if d.MinAffection != nil {
+		parts = append(parts, "with high affection")
+	}
+	if d.MinBeauty != nil {
+		parts = append(parts, "with high beauty")
+	}
+	switch d.TimeOfDay {
+	case "day":
+		parts = append(parts, "during the day")
+	case "night":
+		parts = append(parts, "at night")
+	case "":
+	default:
+		parts = append(parts, "at "+d.TimeOfDay)
+	}
+	if d.KnownMove != nil {
+		parts = append(parts, "knowing "+d.KnownMove.Name)
+	}
+	if d.KnownMoveType != nil {
+		parts = append(parts, "knowing a "+d.KnownMoveType.Name+" move")
+	}
+	if d.Location != nil {
+		parts = append(parts, "at "+d.Location.Name)
+	}
+	if d.Gender != nil {
+		if *d.Gender == 1 {
+			parts = append(parts, "if female")
+		} else {
+			parts = append(parts, "if male")
+		}
+	}
+	if d.PartySpecies != nil {
+		parts = append(parts, "with "+d.PartySpecies.Name+" in the party")
+	}
+	if d.PartyType != nil {
+		parts = append(parts, "with a "+d.PartyType.Name+" pokemon in the party")
+	}
+	if d.NeedsOverworldRain {
+		parts = append(parts, "in the rain")
+	}
+	if d.TurnUpsideDown {
+		parts = append(parts, "holding the console upside down")
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func commandEvolve(cfg *config.Clicfg, args []string) error {
+	args, _, err := parseFlags(args, nil, nil)
+	if err != nil {
+		return err
+	}
+	if len(args) <= 0 {
+		return errors.New("usage: evolve <id> [item]")
+	}
+
+	id, err := parsePokemonID(args[0])
+	if err != nil {
+		return err
+	}
+	p, _, err := cfg.FindPokemon(id)
+	if err != nil {
+		return err
+	}
+
+	item := ""
+	if len(args) > 1 {
+		item = strings.ToLower(args[1])
+		if cfg.Inventory[item] <= 0 {
+			return fmt.Errorf("no %s left in your bag", item)
+		}
+	}
+
+	target, err := evolutionTarget(cfg, p, item)
+	if err != nil {
+		return err
+	}
+	if target == "" {
+		if item != "" {
+			return fmt.Errorf("%s cannot evolve with %s", p.DisplayName(), item)
+		}
+		return fmt.Errorf("%s is not ready to evolve", p.DisplayName())
+	}
+
+	if item != "" {
+		if err := cfg.UseItem(item); err != nil {
+			return err
+		}
+	}
+	evolve(cfg, p, target)
+
+	return nil
+}
+
+// evolutionTarget returns the species p evolves into by reaching its level,
+// or by using item when it is not empty, or "" if it cannot evolve. Only
+// plain level and item evolutions are supported.
+func evolutionTarget(cfg *config.Clicfg, p *config.Pokemon, item string) (string, error) {
+	species, err := speciesOf(cfg, p.Species)
+	if err != nil {
+		return "", err
+	}
+	chain, err := speciesChain(cfg, species)
+	if err != nil {
+		return "", err
+	}
+	link, ok := chain.Chain.Find(species.Name)
+	if !ok {
+		return "", nil
+	}
+
+	for _, next := range link.EvolvesTo {
+		for _, d := range next.EvolutionDetails {
+			if !simpleEvolution(d) {
+				continue
+			}
+			if item == "" && d.Trigger.Name == "level-up" && d.MinLevel != nil && p.Level >= *d.MinLevel {
+				return next.Species.Name, nil
+			}
+			if item != "" && d.Trigger.Name == "use-item" && d.Item != nil && d.Item.Name == item {
+				return next.Species.Name, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// simpleEvolution reports whether d has no conditions besides its level or
+// item, which are the only ones trainers can meet here.
+func simpleEvolution(d pokeapi.EvolutionDetail) bool {
+	return d.Gender == nil && d.HeldItem == nil && d.KnownMove == nil &&
+		d.KnownMoveType == nil && d.Location == nil && d.MinHappiness == nil &&
+		d.MinBeauty == nil && d.MinAffection == nil && !d.NeedsOverworldRain &&
+		d.PartySpecies == nil && d.PartyType == nil &&
+		d.RelativePhysicalStats == nil && d.TimeOfDay == "" &&
+		d.TradeSpecies == nil && !d.TurnUpsideDown
+}
+
+// evolve turns p into the default pokemon of the target species.
+func evolve(cfg *config.Clicfg, p *config.Pokemon, target string) {
+	fmt.Printf("What? %s is evolving!\n", p.DisplayName())
+	from := p.Species
+	pokemon := target
+	if species, err := cfg.API.GetPokemonSpecies(target); err == nil {
+		pokemon = species.DefaultVariety()
+	}
+
+	// the old ability is kept when either pokemon cannot be fetched
+	if fromData, err := cfg.API.GetPokemon(from); err == nil {
+		if toData, err := cfg.API.GetPokemon(pokemon); err == nil {
+			ability := evolvedAbility(fromData, toData, p.Ability)
+			if ability == "" {
+				ability = rollAbility(toData, rand.Intn)
+			}
+			if ability != "" {
+				p.Ability = ability
+			}
+		}
+	}
+	p.Species = pokemon
+	cfg.AddPokemon(pokemon)
+	if p.Nickname != "" {
+		fmt.Printf("%s evolved from %s into %s!\n", p.Nickname, from, target)
+	} else {
+		fmt.Printf("%s evolved into %s!\n", from, target)
+	}
+}
+
+// evolutionChain fetches the evolution chain of a species or pokemon.
+func evolutionChain(cfg *config.Clicfg, name string) (pokeapi.EvolutionChain, error) {
+	species, err := speciesOf(cfg, name)
+	if err != nil {
+		return pokeapi.EvolutionChain{}, err
+	}
+	return speciesChain(cfg, species)
+}
+
+// speciesChain fetches the evolution chain species belongs to.
+func speciesChain(cfg *config.Clicfg, species pokeapi.PokemonSpecies) (pokeapi.EvolutionChain, error) {
+	id, ok := species.EvolutionChainID()
+	if !ok {
+		return pokeapi.EvolutionChain{}, fmt.Errorf("%s has no evolution chain", species.Name)
+	}
+	return cfg.API.GetEvolutionChain(id)
+}
+
+// speciesOf fetches the species called name, or else the species of the
+// pokemon called name, as forms like deoxys-normal are named differently
+// from their species.
+func speciesOf(cfg *config.Clicfg, name string) (pokeapi.PokemonSpecies, error) {
+	if species, err := cfg.API.GetPokemonSpecies(name); err == nil {
+		return species, nil
+	}
+	p, err := cfg.API.GetPokemon(name)
+	if err != nil {
+		return pokeapi.PokemonSpecies{}, err
+	}
+	return cfg.API.GetPokemonSpecies(p.Species.Name)
+}
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+func TestDescribeEvolution(t *testing.T) {
+	level := 16
+	d := pokeapi.EvolutionDetail{Trigger: pokeapi.NamedAPIResource{Name: "level-up"}, MinLevel: &level}
+	if s := describeEvolution(d); s != "level 16" {
+		t.Fatalf("got %q", s)
+	}
+	if !simpleEvolution(d) {
+		t.Fatal("plain level evolution should be simple")
+	}
+
+	happiness := 220
+	d = pokeapi.EvolutionDetail{Trigger: pokeapi.NamedAPIResource{Name: "level-up"}, MinHappiness: &happiness, TimeOfDay: "night"}
+	if s := describeEvolution(d); s != "level up with high friendship at night" {
+		t.Fatalf("got %q", s)
+	}
+	if simpleEvolution(d) {
+		t.Fatal("friendship evolution should not be simple")
+	}
+
+	stone := pokeapi.NamedAPIResource{Name: "thunder-stone"}
+	d = pokeapi.EvolutionDetail{Trigger: pokeapi.NamedAPIResource{Name: "use-item"}, Item: &stone}
+	if s := describeEvolution(d); s != "use thunder-stone" {
+		t.Fatalf("got %q", s)
+	}
+}
+
+func TestEvolveIntoDefaultVariety(t *testing.T) {
+	cfg := testCfg(mapFetcher{
+		"pokemon-species/burmy":    `{"name":"burmy","evolution_chain":{"url":"https://pokeapi.co/api/v2/evolution-chain/213/"}}`,
+		"pokemon-species/wormadam": `{"name":"wormadam","evolution_chain":{"url":"https://pokeapi.co/api/v2/evolution-chain/213/"},"varieties":[{"is_default":true,"pokemon":{"name":"wormadam-plant"}}]}`,
+		"evolution-chain/213": `{"chain":{"species":{"name":"burmy"},"evolves_to":[
+			{"species":{"name":"wormadam"},"evolution_details":[{"trigger":{"name":"level-up"},"min_level":20}]}]}}`,
+		"pokemon/burmy":          `{"name":"burmy","species":{"name":"burmy"},"abilities":[{"slot":1,"ability":{"name":"shed-skin"}}]}`,
+		"pokemon/wormadam-plant": `{"name":"wormadam-plant","species":{"name":"wormadam"},"abilities":[{"slot":1,"ability":{"name":"anticipation"}}]}`,
+	})
+
+	p := &config.Pokemon{Species: "burmy", Level: 20, Ability: "shed-skin"}
+	target, err := evolutionTarget(cfg, p, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target != "wormadam" {
+		t.Fatalf("got target %q", target)
+	}
+
+	evolve(cfg, p, target)
+	if p.Species != "wormadam-plant" || p.Ability != "anticipation" {
+		t.Fatalf("evolved into %s with %s", p.Species, p.Ability)
+	}
+
+	// the evolved pokemon is not named like its species
+	chain, err := evolutionChain(cfg, "wormadam-plant")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if chain.Chain.Species.Name != "burmy" {
+		t.Fatalf("got chain of %s", chain.Chain.Species.Name)
+	}
+}
+
+func TestEvolveRollsUnmatchedAbility(t *testing.T) {
+	cfg := testCfg(mapFetcher{
+		"pokemon-species/vaporeon": `{"name":"vaporeon","varieties":[{"is_default":true,"pokemon":{"name":"vaporeon"}}]}`,
+		"pokemon/eevee":            `{"name":"eevee","abilities":[{"slot":1,"ability":{"name":"run-away"}},{"slot":2,"ability":{"name":"adaptability"}},{"slot":3,"is_hidden":true,"ability":{"name":"anticipation"}}]}`,
+		"pokemon/vaporeon":         `{"name":"vaporeon","abilities":[{"slot":1,"ability":{"name":"water-absorb"}},{"slot":3,"is_hidden":true,"ability":{"name":"hydration"}}]}`,
+	})
+
+	// vaporeon has nothing in eevee's second slot
+	p := &config.Pokemon{Species: "eevee", Level: 20, Ability: "adaptability"}
+	evolve(cfg, p, "vaporeon")
+	if p.Species != "vaporeon" || p.Ability != "water-absorb" {
+		t.Fatalf("evolved into %s with %q", p.Species, p.Ability)
+	}
+}
+
+func TestEvolveKeepsAbilityOffline(t *testing.T) {
+	cfg := testCfg(mapFetcher{
+		"pokemon-species/raichu": `{"name":"raichu","varieties":[{"is_default":true,"pokemon":{"name":"raichu"}}]}`,
+	})
+
+	p := &config.Pokemon{Species: "pikachu", Level: 30, Ability: "static"}
+	evolve(cfg, p, "raichu")
+	if p.Species != "raichu" || p.Ability != "static" {
+		t.Fatalf("evolved into %s with %q", p.Species, p.Ability)
+	}
+}
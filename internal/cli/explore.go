@@ -1,101 +1,111 @@
 package cli
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
 )
 
-type exploreData struct {
-	EncounterMethodRates []struct {
-		EncounterMethod struct {
-			Name *string `json:"name"`
-			URL  *string `json:"url"`
-		} `json:"encounter_method"`
-		VersionDetails []struct {
-			Rate    int `json:"rate"`
-			Version struct {
-				Name *string `json:"name"`
-				URL  *string `json:"url"`
-			} `json:"version"`
-		} `json:"version_details"`
-	} `json:"encounter_method_rates"`
-	GameIndex int `json:"game_index"`
-	ID        int `json:"id"`
-	Location  struct {
-		Name *string `json:"name"`
-		URL  *string `json:"url"`
-	} `json:"location"`
-	Name  *string `json:"name"`
-	Names []struct {
-		Language struct {
-			Name *string `json:"name"`
-			URL  *string `json:"url"`
-		} `json:"language"`
-		Name *string `json:"name"`
-	} `json:"names"`
-	PokemonEncounters []struct {
-		Pokemon struct {
-			Name *string `json:"name"`
-			URL  *string `json:"url"`
-		} `json:"pokemon"`
-		VersionDetails []struct {
-			EncounterDetails []struct {
-				Chance          int   `json:"chance"`
-				ConditionValues []any `json:"condition_values"`
-				MaxLevel        int   `json:"max_level"`
-				Method          struct {
-					Name *string `json:"name"`
-					URL  *string `json:"url"`
-				} `json:"method"`
-				MinLevel int `json:"min_level"`
-			} `json:"encounter_details"`
-			MaxChance int `json:"max_chance"`
-			Version   struct {
-				Name *string `json:"name"`
-				URL  *string `json:"url"`
-			} `json:"version"`
-		} `json:"version_details"`
-	} `json:"pokemon_encounters"`
-}
-
 func commandExplore(cfg *config.Clicfg, args []string) error {
+	args, flags, err := parseFlags(args, []string{"version", "method"}, []string{"detail"})
+	if err != nil {
+		return err
+	}
 	if len(args) <= 0 {
 		return errors.New("no location argument given")
 	}
-	url := "https://pokeapi.co/api/v2/location-area/" + args[0]
 
-	body, err := cfg.Cache.Get(url)
+	name, err := resolveName(cfg, "location-area", args[0])
 	if err != nil {
-		resp, err := http.Get(url)
-		if err != nil {
-			return err
-		}
+		return err
+	}
+	respData, err := cfg.API.GetLocationArea(name)
+	if err != nil {
+		return err
+	}
 
-		body, err = io.ReadAll(resp.Body)
-		resp.Body.Close()
-		if resp.StatusCode > 299 {
-			return errors.New("failed response")
+	if cfg.Location != respData.Name {
+		cfg.Wild = nil
+	}
+	cfg.Location = respData.Name
+	fmt.Printf("Exploring %s...\n", localAreaName(cfg, respData))
+
+	version := strings.ToLower(flags["version"])
+	method := strings.ToLower(flags["method"])
+	filtered := version != "" || method != ""
+	rows := encounterRows(respData, version, method)
+
+	if len(respData.PokemonEncounters) <= 0 {
+		fmt.Printf("No wild pokemon live in %s\n", respData.Name)
+		return nil
+	}
+	if filtered && len(rows) <= 0 {
+		filters := []string{}
+		if version != "" {
+			filters = append(filters, "version "+version)
 		}
-		if err != nil {
-			return nil
+		if method != "" {
+			filters = append(filters, "method "+method)
 		}
-		defer cfg.Cache.Add(url, body)
+		fmt.Printf("No encounters match %s\n", strings.Join(filters, " and "))
+		return nil
 	}
 
-	respData := exploreData{}
-	err = json.Unmarshal(body, &respData)
-	if err != nil {
-		return err
+	if flags["detail"] != "" {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "POKEMON\tLEVEL\tCHANCE\tMETHOD\tVERSION")
+		for _, r := range rows {
+			fmt.Fprintf(w, "%s\t%s\t%d%%\t%s\t%s\n", pokemonName(cfg, r.pokemon), levelRange(r.MinLevel, r.MaxLevel), r.Chance, r.Method.Name, r.version)
+		}
+		if !filtered {
+			for _, name := range pokemonWithoutRows(respData, rows) {
+				fmt.Fprintf(w, "%s\t-\t-\t-\t-\n", pokemonName(cfg, name))
+			}
+		}
+		return w.Flush()
 	}
 
-	for i := range respData.PokemonEncounters {
-		fmt.Printf(" - %s\n", *respData.PokemonEncounters[i].Pokemon.Name)
+	for _, name := range explorePokemon(respData, rows, filtered) {
+		fmt.Printf(" - %s\n", pokemonName(cfg, name))
 	}
 
 	return nil
 }
+
+// explorePokemon lists the distinct pokemon of the rows, or every pokemon of
+// the area when the rows are not filtered, including any the area lists
+// without encounter details.
+func explorePokemon(area pokeapi.LocationArea, rows []encounterRow, filtered bool) []string {
+	names := []string{}
+	if !filtered {
+		for _, pe := range area.PokemonEncounters {
+			if !slices.Contains(names, pe.Pokemon.Name) {
+				names = append(names, pe.Pokemon.Name)
+			}
+		}
+		return names
+	}
+	for _, r := range rows {
+		if !slices.Contains(names, r.pokemon) {
+			names = append(names, r.pokemon)
+		}
+	}
+	return names
+}
+
+// pokemonWithoutRows lists the pokemon of the area that have no rows.
+func pokemonWithoutRows(area pokeapi.LocationArea, rows []encounterRow) []string {
+	names := []string{}
+	for _, pe := range area.PokemonEncounters {
+		if !slices.ContainsFunc(rows, func(r encounterRow) bool { return r.pokemon == pe.Pokemon.Name }) {
+			names = append(names, pe.Pokemon.Name)
+		}
+	}
+	return names
+}
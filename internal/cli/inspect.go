@@ -1,11 +1,11 @@
 package cli
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 
 	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
 )
 
 func commandInspect(cfg *config.Clicfg, args []string) error {
@@ -13,20 +13,16 @@ func commandInspect(cfg *config.Clicfg, args []string) error {
 		return errors.New("no pokemon given as argument")
 	}
 
-	url := "https://pokeapi.co/api/v2/pokemon/" + args[0]
-
-	body, err := cfg.Cache.Get(url)
+	name, err := resolveName(cfg, "pokemon", args[0])
 	if err != nil {
 		return err
 	}
-
-	respData := pokemonData{}
-	err = json.Unmarshal(body, &respData)
+	respData, err := cfg.API.GetPokemon(name)
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("Name: %s\n", respData.Name)
+	fmt.Printf("Name: %s\n", pokemonName(cfg, respData.Name))
 	fmt.Printf("Height: %d\n", respData.Height)
 	fmt.Printf("Weight: %d\n", respData.Weight)
 
@@ -40,5 +36,35 @@ func commandInspect(cfg *config.Clicfg, args []string) error {
 		fmt.Printf("  - %s\n", respData.Types[i].Type.Name)
 	}
 
+	fmt.Printf("Abilities:\n")
+	for _, a := range respData.Abilities {
+		if a.IsHidden {
+			fmt.Printf("  - %s (hidden)\n", a.Ability.Name)
+		} else {
+			fmt.Printf("  - %s\n", a.Ability.Name)
+		}
+	}
+
+	owned := []*config.Pokemon{}
+	for _, p := range cfg.Caught() {
+		if p.Species == respData.Name {
+			owned = append(owned, p)
+		}
+	}
+	if len(owned) > 0 {
+		// the rest of the report does not depend on the growth rate
+		var growth *pokeapi.GrowthRate
+		if g, err := growthRate(cfg, respData.Name); err == nil {
+			growth = &g
+		}
+
+		fmt.Printf("Yours:\n")
+		for _, p := range owned {
+			fmt.Printf("  - %s\n", p)
+			fmt.Printf("      %s\n", statLine(respData, p, growth))
+			fmt.Printf("      %s\n", geneLine(p))
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,29 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+)
+
+func TestInspectOwnedWithoutGrowthRate(t *testing.T) {
+	// offline bundles may lack the species and its growth rate
+	cfg := testCfg(mapFetcher{
+		"pokemon/?offset=0&limit=100000": `{"results":[{"name":"pikachu","url":"https://pokeapi.co/api/v2/pokemon/25/"}]}`,
+		"pokemon/pikachu":                `{"name":"pikachu","stats":[{"base_stat":35,"stat":{"name":"hp"}},{"base_stat":90,"stat":{"name":"speed"}}]}`,
+	})
+	cfg.ApplyState(newState())
+	cfg.Party = []*config.Pokemon{{ID: 1, Species: "pikachu", Level: 10, Nature: "timid", IVs: config.Stats{Speed: 31}, EVs: config.Stats{HP: 4}}}
+
+	out, err := captureStdout(t, func() error { return commandInspect(cfg, []string{"pikachu"}) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "timid nature") || !strings.Contains(out, "IVs HP 0, Atk 0, Def 0, SpA 0, SpD 0, Spe 31; EVs HP 4") {
+		t.Fatalf("owned pokemon not reported, got %q", out)
+	}
+	if strings.Contains(out, "exp") {
+		t.Fatalf("experience shown without a growth rate, got %q", out)
+	}
+}
@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+func commandLanguage(cfg *config.Clicfg, args []string) error {
+	if len(args) <= 0 || args[0] == "" {
+		fmt.Printf("Language: %s\n", cfg.Language)
+		fmt.Printf("Available: %s\n", strings.Join(pokeapi.Languages, ", "))
+		return nil
+	}
+
+	if !slices.Contains(pokeapi.Languages, args[0]) {
+		return fmt.Errorf("unknown language %q, use one of %s", args[0], strings.Join(pokeapi.Languages, ", "))
+	}
+	cfg.Language = args[0]
+	fmt.Printf("Language set to %s for this session\n", cfg.Language)
+
+	return nil
+}
+
+// localized reports whether names should be translated, English output
+// keeps the plain resource names the commands take.
+func localized(cfg *config.Clicfg) bool {
+	return cfg.Language != "" && cfg.Language != "en"
+}
+
+// localName picks the trainer's language from names, falling back to
+// English. It is empty when names has neither.
+func localName(cfg *config.Clicfg, names []pokeapi.Name) string {
+	if name, ok := pokeapi.LocalizedName(names, cfg.Language); ok {
+		return name
+	}
+	name, _ := pokeapi.LocalizedName(names, "en")
+	return name
+}
+
+// withName shows a translated name next to the resource name to type in
+// commands.
+func withName(local, name string) string {
+	if local == "" || local == name {
+		return name
+	}
+	return fmt.Sprintf("%s (%s)", local, name)
+}
+
+// pokemonName is pokemon as displayed in the trainer's language. Failing
+// lookups leave the name untranslated rather than failing the command.
+func pokemonName(cfg *config.Clicfg, pokemon string) string {
+	if !localized(cfg) {
+		return pokemon
+	}
+	p, err := cfg.API.GetPokemon(pokemon)
+	if err != nil {
+		return pokemon
+	}
+	species, err := cfg.API.GetPokemonSpecies(p.Species.Name)
+	if err != nil {
+		return pokemon
+	}
+	return withName(localName(cfg, species.Names), pokemon)
+}
+
+// areaName is a location area as displayed in the trainer's language. Few
+// areas are translated, so it falls back to the name of their location.
+func areaName(cfg *config.Clicfg, area string) string {
+	if !localized(cfg) {
+		return area
+	}
+	if name, ok := cfg.AreaNames[cfg.Language+"/"+area]; ok {
+		return name
+	}
+	a, err := cfg.API.GetLocationArea(area)
+	if err != nil {
+		return area
+	}
+	return localAreaName(cfg, a)
+}
+
+// localAreaName translates a, remembering the result for the session so
+// listing a map page again does not fetch every area's location.
+func localAreaName(cfg *config.Clicfg, a pokeapi.LocationArea) string {
+	if !localized(cfg) {
+		return a.Name
+	}
+	key := cfg.Language + "/" + a.Name
+	if name, ok := cfg.AreaNames[key]; ok {
+		return name
+	}
+	if name, ok := pokeapi.LocalizedName(a.Names, cfg.Language); ok {
+		return rememberAreaName(cfg, key, withName(name, a.Name))
+	}
+	l, err := cfg.API.GetLocation(a.Location.Name)
+	if err != nil {
+		// not remembered, the location may be fetched next time
+		return withName(localName(cfg, a.Names), a.Name)
+	}
+	if name, ok := pokeapi.LocalizedName(l.Names, cfg.Language); ok {
+		return rememberAreaName(cfg, key, withName(name, a.Name))
+	}
+	return rememberAreaName(cfg, key, withName(localName(cfg, a.Names), a.Name))
+}
+
+func rememberAreaName(cfg *config.Clicfg, key, name string) string {
+	if cfg.AreaNames == nil {
+		cfg.AreaNames = map[string]string{}
+	}
+	cfg.AreaNames[key] = name
+	return name
+}
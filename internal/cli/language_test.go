@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+func TestLocalName(t *testing.T) {
+	names := []pokeapi.Name{
+		{Name: "ピカチュウ", Language: pokeapi.NamedAPIResource{Name: "ja"}},
+		{Name: "Pikachu", Language: pokeapi.NamedAPIResource{Name: "en"}},
+	}
+
+	cfg := &config.Clicfg{Language: "ja"}
+	if name := localName(cfg, names); name != "ピカチュウ" {
+		t.Fatalf("got %q", name)
+	}
+	if name := withName(localName(cfg, names), "pikachu"); name != "ピカチュウ (pikachu)" {
+		t.Fatalf("got %q", name)
+	}
+
+	cfg.Language = "de"
+	if name := localName(cfg, names); name != "Pikachu" {
+		t.Fatalf("got %q, want the English fallback", name)
+	}
+
+	cfg.Language = "en"
+	if localized(cfg) {
+		t.Fatal("English should keep the plain names")
+	}
+	if name := pokemonName(cfg, "pikachu"); name != "pikachu" {
+		t.Fatalf("got %q", name)
+	}
+}
+
+func TestAreaNameRemembered(t *testing.T) {
+	cfg := testCfg(mapFetcher{
+		"location-area/viridian-forest-area": `{"name":"viridian-forest-area","location":{"name":"viridian-forest"}}`,
+		"location/viridian-forest":           `{"name":"viridian-forest","names":[{"name":"トキワのもり","language":{"name":"ja"}}]}`,
+	})
+	cfg.Language = "ja"
+
+	want := "トキワのもり (viridian-forest-area)"
+	if name := areaName(cfg, "viridian-forest-area"); name != want {
+		t.Fatalf("got %q, want %q", name, want)
+	}
+
+	// listing the area again does not fetch it or its location
+	cfg.API = pokeapi.NewClient(testBaseURL, mapFetcher{})
+	if name := areaName(cfg, "viridian-forest-area"); name != want {
+		t.Fatalf("got %q after the first lookup", name)
+	}
+
+	cfg.Language = "de"
+	if name := areaName(cfg, "viridian-forest-area"); name != "viridian-forest-area" {
+		t.Fatalf("got %q for another language", name)
+	}
+}
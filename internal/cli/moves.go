@@ -0,0 +1,163 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+func commandMoves(cfg *config.Clicfg, args []string) error {
+	args, flags, err := parseFlags(args, []string{"version-group", "method"}, nil)
+	if err != nil {
+		return err
+	}
+	if len(args) <= 0 {
+		return errors.New("no pokemon argument given")
+	}
+
+	respData, err := cfg.API.GetPokemon(args[0])
+	if err != nil {
+		return err
+	}
+
+	versionGroup := strings.ToLower(flags["version-group"])
+	if versionGroup == "" {
+		versionGroup = latestVersionGroup(respData)
+	}
+	method := strings.ToLower(flags["method"])
+
+	rows := learnset(respData, versionGroup, method)
+	if len(rows) <= 0 {
+		fmt.Printf("%s learns no moves in %s\n", respData.Name, versionGroup)
+		return nil
+	}
+
+	fmt.Printf("Moves %s learns in %s:\n", respData.Name, versionGroup)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "LEVEL\tMOVE\tMETHOD")
+	for _, r := range rows {
+		level := "-"
+		if r.LevelLearnedAt > 0 {
+			level = fmt.Sprintf("%d", r.LevelLearnedAt)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", level, r.move, r.MoveLearnMethod.Name)
+	}
+
+	return w.Flush()
+}
+
+type learnsetRow struct {
+	move string
+	pokeapi.PokemonMoveVersion
+}
+
+// learnset lists the moves p learns in versionGroup, optionally only by
+// method, sorted by method and then level.
+func learnset(p pokeapi.Pokemon, versionGroup, method string) []learnsetRow {
+	rows := []learnsetRow{}
+	for _, m := range p.Moves {
+		for _, vg := range m.VersionGroupDetails {
+			if vg.VersionGroup.Name != versionGroup {
+				continue
+			}
+			if method != "" && vg.MoveLearnMethod.Name != method {
+				continue
+			}
+			rows = append(rows, learnsetRow{m.Move.Name, vg})
+		}
+	}
+
+	slices.SortStableFunc(rows, func(a, b learnsetRow) int {
+		if c := strings.Compare(a.MoveLearnMethod.Name, b.MoveLearnMethod.Name); c != 0 {
+			return c
+		}
+		if a.LevelLearnedAt != b.LevelLearnedAt {
+			return a.LevelLearnedAt - b.LevelLearnedAt
+		}
+		return strings.Compare(a.move, b.move)
+	})
+	return rows
+}
+
+// versionGroupOrder lists PokeAPI's version groups in the release order of
+// their games. Their IDs do not follow it, colosseum and xd come after
+// black-white and the Japanese red and green after scarlet-violet.
+var versionGroupOrder = []string{
+	"red-green-japan", "blue-japan", "red-blue", "yellow",
+	"gold-silver", "crystal",
+	"ruby-sapphire", "colosseum", "firered-leafgreen", "emerald", "xd",
+	"diamond-pearl", "platinum", "heartgold-soulsilver",
+	"black-white", "black-2-white-2",
+	"x-y", "omega-ruby-alpha-sapphire",
+	"sun-moon", "ultra-sun-ultra-moon", "lets-go-pikachu-lets-go-eevee",
+	"sword-shield", "the-isle-of-armor", "the-crown-tundra",
+	"brilliant-diamond-and-shining-pearl", "legends-arceus",
+	"scarlet-violet", "the-teal-mask", "the-indigo-disk",
+}
+
+// latestVersionGroup picks the newest version group in p's move data by
+// versionGroupOrder. Groups missing from it are from games released since,
+// newest by ID among themselves.
+func latestVersionGroup(p pokeapi.Pokemon) string {
+	rank := func(vg pokeapi.NamedAPIResource) int {
+		if i := slices.Index(versionGroupOrder, vg.Name); i >= 0 {
+			return i
+		}
+		id, _ := vg.ID()
+		return len(versionGroupOrder) + id
+	}
+
+	latest, latestRank := "", -1
+	for _, m := range p.Moves {
+		for _, vg := range m.VersionGroupDetails {
+			if r := rank(vg.VersionGroup); r > latestRank {
+				latest, latestRank = vg.VersionGroup.Name, r
+			}
+		}
+	}
+	return latest
+}
+
+func commandMove(cfg *config.Clicfg, args []string) error {
+	if len(args) <= 0 || args[0] == "" {
+		return errors.New("no move argument given")
+	}
+
+	respData, err := cfg.API.GetMove(args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Name: %s\n", respData.Name)
+	fmt.Printf("Type: %s\n", respData.Type.Name)
+	fmt.Printf("Class: %s\n", respData.DamageClass.Name)
+	fmt.Printf("Power: %s\n", optionalInt(respData.Power))
+	fmt.Printf("Accuracy: %s\n", optionalInt(respData.Accuracy))
+	fmt.Printf("PP: %d\n", respData.PP)
+	if respData.Priority != 0 {
+		fmt.Printf("Priority: %+d\n", respData.Priority)
+	}
+
+	if e, ok := pokeapi.EnglishEffect(respData.EffectEntries); ok {
+		chance := "-"
+		if respData.EffectChance != nil {
+			chance = fmt.Sprintf("%d", *respData.EffectChance)
+		}
+		fmt.Printf("Effect: %s\n", strings.ReplaceAll(e.Effect, "$effect_chance", chance))
+	}
+
+	return nil
+}
+
+func optionalInt(v *int) string {
+	if v == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d", *v)
+}
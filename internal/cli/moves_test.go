@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+func TestLearnset(t *testing.T) {
+	ids := map[string]string{"red-blue": "1", "yellow": "2", "sword-shield": "20"}
+	version := func(vg, method string, level int) pokeapi.PokemonMoveVersion {
+		return pokeapi.PokemonMoveVersion{
+			LevelLearnedAt:  level,
+			VersionGroup:    pokeapi.NamedAPIResource{Name: vg, URL: "https://pokeapi.co/api/v2/version-group/" + ids[vg] + "/"},
+			MoveLearnMethod: pokeapi.NamedAPIResource{Name: method},
+		}
+	}
+	p := pokeapi.Pokemon{Moves: []pokeapi.PokemonMove{
+		{Move: pokeapi.NamedAPIResource{Name: "thunderbolt"}, VersionGroupDetails: []pokeapi.PokemonMoveVersion{version("red-blue", "machine", 0)}},
+		{Move: pokeapi.NamedAPIResource{Name: "quick-attack"}, VersionGroupDetails: []pokeapi.PokemonMoveVersion{version("red-blue", "level-up", 16), version("yellow", "level-up", 16)}},
+		{Move: pokeapi.NamedAPIResource{Name: "thunder-shock"}, VersionGroupDetails: []pokeapi.PokemonMoveVersion{version("red-blue", "level-up", 1)}},
+	}}
+	// a newer game listed before older ones in the move data
+	p.Moves[0].VersionGroupDetails = append([]pokeapi.PokemonMoveVersion{version("sword-shield", "machine", 0)}, p.Moves[0].VersionGroupDetails...)
+
+	if vg := latestVersionGroup(p); vg != "sword-shield" {
+		t.Fatalf("latest version group: got %q", vg)
+	}
+
+	// version group IDs are not in release order
+	old := pokeapi.Pokemon{Moves: []pokeapi.PokemonMove{{VersionGroupDetails: []pokeapi.PokemonMoveVersion{
+		{VersionGroup: pokeapi.NamedAPIResource{Name: "black-white", URL: "https://pokeapi.co/api/v2/version-group/11/"}},
+		{VersionGroup: pokeapi.NamedAPIResource{Name: "colosseum", URL: "https://pokeapi.co/api/v2/version-group/12/"}},
+		{VersionGroup: pokeapi.NamedAPIResource{Name: "scarlet-violet", URL: "https://pokeapi.co/api/v2/version-group/25/"}},
+		{VersionGroup: pokeapi.NamedAPIResource{Name: "red-green-japan", URL: "https://pokeapi.co/api/v2/version-group/28/"}},
+	}}}}
+	if vg := latestVersionGroup(old); vg != "scarlet-violet" {
+		t.Fatalf("latest version group: got %q", vg)
+	}
+	old.Moves[0].VersionGroupDetails = old.Moves[0].VersionGroupDetails[:2]
+	if vg := latestVersionGroup(old); vg != "black-white" {
+		t.Fatalf("latest version group: got %q", vg)
+	}
+
+	rows := learnset(p, "red-blue", "")
+	want := []string{"thunder-shock", "quick-attack", "thunderbolt"}
+	if len(rows) != len(want) {
+		t.Fatalf("got %d rows, want %d", len(rows), len(want))
+	}
+	for i := range want {
+		if rows[i].move != want[i] {
+			t.Fatalf("row %d: got %s, want %s", i, rows[i].move, want[i])
+		}
+	}
+
+	if rows := learnset(p, "red-blue", "machine"); len(rows) != 1 || rows[0].move != "thunderbolt" {
+		t.Fatalf("method filter: got %v", rows)
+	}
+}
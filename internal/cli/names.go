@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+// maxSuggestions caps the "did you mean" list.
+const maxSuggestions = 3
+
+// resolveName maps what the trainer typed to the name of a resource, using
+// the full listing of resource as an index. In offline mode without the
+// index bundled the input is used as is, other fetch errors are returned.
+func resolveName(cfg *config.Clicfg, resource, input string) (string, error) {
+	index, err := cfg.API.ListAll(resource)
+	if errors.Is(err, config.ErrNotBundled) {
+		return input, nil
+	} else if err != nil {
+		return "", err
+	}
+	return matchName(index.Results, resource, input)
+}
+
+// matchName finds input in index. Names match case-insensitively and
+// numbers match resource IDs, such as national dex numbers. Unknown names
+// fail with the closest names as suggestions.
+func matchName(index []pokeapi.NamedAPIResource, resource, input string) (string, error) {
+	name := strings.ToLower(strings.TrimSpace(input))
+
+	if id, err := strconv.Atoi(name); err == nil {
+		for _, r := range index {
+			if rid, ok := r.ID(); ok && rid == id {
+				return r.Name, nil
+			}
+		}
+		return "", fmt.Errorf("there is no %s #%d", resource, id)
+	}
+
+	for _, r := range index {
+		if r.Name == name {
+			return r.Name, nil
+		}
+	}
+
+	suggestions := suggestNames(index, name)
+	if len(suggestions) <= 0 {
+		return "", fmt.Errorf("unknown %s %q", resource, input)
+	}
+	return "", fmt.Errorf("unknown %s %q, did you mean %s?", resource, input, strings.Join(suggestions, ", "))
+}
+
+// suggestNames lists the names of index within a few typos of name, or
+// starting with it, closest first.
+func suggestNames(index []pokeapi.NamedAPIResource, name string) []string {
+	type candidate struct {
+		name     string
+		distance int
+	}
+
+	threshold := max(1, len(name)/3)
+	candidates := []candidate{}
+	for _, r := range index {
+		d := editDistance(name, r.Name)
+		if d <= threshold || (len(name) >= 3 && strings.HasPrefix(r.Name, name)) {
+			candidates = append(candidates, candidate{r.Name, d})
+		}
+	}
+
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		if a.distance != b.distance {
+			return a.distance - b.distance
+		}
+		return strings.Compare(a.name, b.name)
+	})
+
+	names := []string{}
+	for i := range min(len(candidates), maxSuggestions) {
+		names = append(names, candidates[i].name)
+	}
+	return names
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := range ra {
+		cur[0] = i + 1
+		for j := range rb {
+			cost := 1
+			if ra[i] == rb[j] {
+				cost = 0
+			}
+			cur[j+1] = min(prev[j+1]+1, cur[j]+1, prev[j]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+// notBundled fails every fetch like an offline cache without the response.
+type notBundled struct{}
+
+func (notBundled) Get(url string) ([]byte, error) {
+	return nil, fmt.Errorf("%s: %w", url, config.ErrNotBundled)
+}
+
+func TestMatchName(t *testing.T) {
+	index := []pokeapi.NamedAPIResource{
+		{Name: "pikachu", URL: "https://pokeapi.co/api/v2/pokemon/25/"},
+		{Name: "raichu", URL: "https://pokeapi.co/api/v2/pokemon/26/"},
+		{Name: "charmander", URL: "https://pokeapi.co/api/v2/pokemon/4/"},
+		{Name: "charmeleon", URL: "https://pokeapi.co/api/v2/pokemon/5/"},
+	}
+
+	for input, want := range map[string]string{"pikachu": "pikachu", "Pikachu": "pikachu", "25": "pikachu", "4": "charmander"} {
+		name, err := matchName(index, "pokemon", input)
+		if err != nil || name != want {
+			t.Fatalf("%q: got %q, %v, want %q", input, name, err, want)
+		}
+	}
+
+	_, err := matchName(index, "pokemon", "pikchu")
+	if err == nil || !strings.Contains(err.Error(), "did you mean pikachu?") {
+		t.Fatalf("got %v", err)
+	}
+	_, err = matchName(index, "pokemon", "charm")
+	if err == nil || !strings.Contains(err.Error(), "did you mean charmander, charmeleon?") {
+		t.Fatalf("got %v", err)
+	}
+	if _, err := matchName(index, "pokemon", "151"); err == nil {
+		t.Fatal("expected an error for a missing dex number")
+	}
+	if _, err := matchName(index, "pokemon", "zzzzzz"); err == nil || strings.Contains(err.Error(), "did you mean") {
+		t.Fatalf("got %v", err)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"pikachu", "pikachu", 0},
+		{"pikchu", "pikachu", 1},
+		{"kitten", "sitting", 3},
+		{"", "abc", 3},
+	}
+	for _, c := range cases {
+		if d := editDistance(c.a, c.b); d != c.want {
+			t.Fatalf("%s/%s: got %d, want %d", c.a, c.b, d, c.want)
+		}
+	}
+}
+
+func TestResolveName(t *testing.T) {
+	cfg := testCfg(mapFetcher{
+		"pokemon/?offset=0&limit=100000": `{"results":[{"name":"pikachu","url":"https://pokeapi.co/api/v2/pokemon/25/"}]}`,
+	})
+	if name, err := resolveName(cfg, "pokemon", "25"); err != nil || name != "pikachu" {
+		t.Fatalf("got %q, %v", name, err)
+	}
+
+	// a failing index is an error, not an unchecked name
+	if _, err := resolveName(cfg, "location-area", "viridian-forest-area"); err == nil {
+		t.Fatal("expected the index fetch error")
+	}
+
+	cfg.API = pokeapi.NewClient(testBaseURL, notBundled{})
+	if name, err := resolveName(cfg, "pokemon", "Pikachu"); err != nil || name != "Pikachu" {
+		t.Fatalf("got %q, %v offline without the index", name, err)
+	}
+}
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+const (
+	envConfig   = "POKEDEX_CONFIG"
+	envBaseURL  = "POKEDEX_BASE_URL"
+	envOffline  = "POKEDEX_OFFLINE"
+	envBundle   = "POKEDEX_BUNDLE"
+	envProfile  = "POKEDEX_PROFILE"
+	envLanguage = "POKEDEX_LANGUAGE"
+)
+
+// loadSettings resolves the settings from, in order of precedence, command
+// line flags, environment variables, the config file and the defaults.
+func loadSettings(args []string) (config.Settings, error) {
+	fs := flag.NewFlagSet("pokedexcli", flag.ContinueOnError)
+	configPath := fs.String("config", "", "path to the JSON config file (env "+envConfig+")")
+	baseURL := fs.String("base-url", "", "PokeAPI base URL (env "+envBaseURL+")")
+	offline := fs.Bool("offline", false, "serve all data from the offline bundle (env "+envOffline+")")
+	profile := fs.String("profile", "", "trainer profile to play as (env "+envProfile+")")
+	language := fs.String("language", "", "language of names and pokedex entries, like ja or de (env "+envLanguage+")")
+	bundlePath := fs.String("bundle", "", "offline bundle directory or .zip, defaults to the cache dir (env "+envBundle+")")
+	if err := fs.Parse(args); err != nil {
+		return config.Settings{}, err
+	}
+
+	path := firstNonEmpty(*configPath, os.Getenv(envConfig))
+	if path == "" {
+		dir, err := config.DefaultConfigDir()
+		if err == nil {
+			path = filepath.Join(dir, "config.json")
+		}
+	}
+
+	s := config.Settings{}
+	if path != "" {
+		var err error
+		s, err = config.LoadSettings(path)
+		if err != nil {
+			return s, fmt.Errorf("reading config %s: %w", path, err)
+		}
+	}
+
+	s.BaseURL = firstNonEmpty(*baseURL, os.Getenv(envBaseURL), s.BaseURL, pokeapi.DefaultBaseURL)
+	s.Bundle = firstNonEmpty(*bundlePath, os.Getenv(envBundle), s.Bundle)
+	s.Profile = firstNonEmpty(*profile, os.Getenv(envProfile), s.Profile, config.DefaultProfile)
+	if err := config.ValidateProfileName(s.Profile); err != nil {
+		return s, err
+	}
+
+	s.Language = firstNonEmpty(*language, os.Getenv(envLanguage), s.Language, "en")
+	if !slices.Contains(pokeapi.Languages, s.Language) {
+		return s, fmt.Errorf("unknown language %q, use one of %s", s.Language, strings.Join(pokeapi.Languages, ", "))
+	}
+
+	if *offline {
+		s.Offline = true
+	} else if v := os.Getenv(envOffline); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return s, fmt.Errorf("%s: %w", envOffline, err)
+		}
+		s.Offline = b
+	}
+
+	return s, nil
+}
+
+func firstNonEmpty(vals ...string) string {
+	for _, v := range vals {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
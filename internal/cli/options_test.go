@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+func TestLoadSettingsPrecedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	err := os.WriteFile(path, []byte(`{"base_url":"http://file/api/v2/"}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(envConfig, path)
+	t.Setenv(envBaseURL, "")
+
+	s, err := loadSettings(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.BaseURL != "http://file/api/v2/" {
+		t.Fatalf("config file base url not used, got %q", s.BaseURL)
+	}
+
+	t.Setenv(envBaseURL, "http://env/api/v2/")
+	s, _ = loadSettings(nil)
+	if s.BaseURL != "http://env/api/v2/" {
+		t.Fatalf("env base url did not override config file, got %q", s.BaseURL)
+	}
+
+	s, _ = loadSettings([]string{"-base-url", "http://flag/api/v2/"})
+	if s.BaseURL != "http://flag/api/v2/" {
+		t.Fatalf("flag base url did not override env, got %q", s.BaseURL)
+	}
+}
+
+func TestLoadSettingsDefault(t *testing.T) {
+	t.Setenv(envConfig, filepath.Join(t.TempDir(), "missing.json"))
+	t.Setenv(envBaseURL, "")
+
+	s, err := loadSettings(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.BaseURL != pokeapi.DefaultBaseURL {
+		t.Fatalf("expected default base url, got %q", s.BaseURL)
+	}
+}
+
+func TestLoadSettingsLanguage(t *testing.T) {
+	t.Setenv(envConfig, filepath.Join(t.TempDir(), "missing.json"))
+	t.Setenv(envLanguage, "")
+
+	s, err := loadSettings(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Language != "en" {
+		t.Fatalf("expected English by default, got %q", s.Language)
+	}
+
+	t.Setenv(envLanguage, "ja")
+	s, _ = loadSettings(nil)
+	if s.Language != "ja" {
+		t.Fatalf("env language not used, got %q", s.Language)
+	}
+
+	if _, err := loadSettings([]string{"-language", "klingon"}); err == nil {
+		t.Fatal("expected an error for an unknown language")
+	}
+}
@@ -0,0 +1,205 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+)
+
+func commandParty(cfg *config.Clicfg, args []string) error {
+	if len(cfg.Party) <= 0 {
+		fmt.Print("Your party is empty...\n")
+		return nil
+	}
+
+	fmt.Printf("Your party (%d/%d)\n", len(cfg.Party), config.PartySize)
+	for _, p := range cfg.Party {
+		fmt.Printf("  - %s\n", pokemonSummary(p))
+	}
+
+	return nil
+}
+
+func commandBox(cfg *config.Clicfg, args []string) error {
+	args, _, err := parseFlags(args, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if len(args) <= 0 {
+		if len(cfg.Boxes) <= 0 {
+			fmt.Print("Your PC boxes are empty...\n")
+			return nil
+		}
+		for i := range cfg.Boxes {
+			fmt.Printf("Box %d (%d/%d)\n", i+1, len(cfg.Boxes[i]), config.BoxSize)
+		}
+		return nil
+	}
+
+	box, err := strconv.Atoi(args[0])
+	if err != nil || box < 1 || box > len(cfg.Boxes) {
+		return fmt.Errorf("there is no box %s", args[0])
+	}
+	if len(cfg.Boxes[box-1]) <= 0 {
+		fmt.Printf("Box %d is empty...\n", box)
+		return nil
+	}
+
+	fmt.Printf("Box %d (%d/%d)\n", box, len(cfg.Boxes[box-1]), config.BoxSize)
+	for _, p := range cfg.Boxes[box-1] {
+		fmt.Printf("  - %s\n", pokemonSummary(p))
+	}
+
+	return nil
+}
+
+func commandDeposit(cfg *config.Clicfg, args []string) error {
+	args, _, err := parseFlags(args, nil, nil)
+	if err != nil {
+		return err
+	}
+	if len(args) <= 0 {
+		return errors.New("usage: deposit <id> [box]")
+	}
+
+	id, err := parsePokemonID(args[0])
+	if err != nil {
+		return err
+	}
+	box := 0
+	if len(args) > 1 {
+		box, err = strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid box %q", args[1])
+		}
+	}
+
+	box, err = cfg.Deposit(id, box)
+	if err != nil {
+		return err
+	}
+	p, _, _ := cfg.FindPokemon(id)
+	fmt.Printf("%s was deposited in box %d\n", p.DisplayName(), box)
+
+	return nil
+}
+
+func commandWithdraw(cfg *config.Clicfg, args []string) error {
+	args, _, err := parseFlags(args, nil, nil)
+	if err != nil {
+		return err
+	}
+	if len(args) <= 0 {
+		return errors.New("usage: withdraw <id>")
+	}
+
+	id, err := parsePokemonID(args[0])
+	if err != nil {
+		return err
+	}
+	if err := cfg.Withdraw(id); err != nil {
+		return err
+	}
+	p, _, _ := cfg.FindPokemon(id)
+	fmt.Printf("%s rejoined your party\n", p.DisplayName())
+
+	return nil
+}
+
+func commandNickname(cfg *config.Clicfg, args []string) error {
+	args, _, err := parseFlags(args, nil, nil)
+	if err != nil {
+		return err
+	}
+	if len(args) <= 0 {
+		return errors.New("usage: nickname <id> [name]")
+	}
+
+	id, err := parsePokemonID(args[0])
+	if err != nil {
+		return err
+	}
+	p, err := cfg.SetNickname(id, strings.Join(args[1:], " "))
+	if err != nil {
+		return err
+	}
+
+	if p.Nickname == "" {
+		fmt.Printf("#%d is just %s again\n", p.ID, p.Species)
+	} else {
+		fmt.Printf("#%d %s is now called %s\n", p.ID, p.Species, p.Nickname)
+	}
+
+	return nil
+}
+
+func commandRelease(cfg *config.Clicfg, args []string) error {
+	args, _, err := parseFlags(args, nil, nil)
+	if err != nil {
+		return err
+	}
+	if len(args) <= 0 {
+		return errors.New("usage: release <id>")
+	}
+
+	id, err := parsePokemonID(args[0])
+	if err != nil {
+		return err
+	}
+	p, box, err := cfg.FindPokemon(id)
+	if err != nil {
+		return err
+	}
+	if box == 0 && len(cfg.Party) <= 1 {
+		return errors.New("you cannot release your last party pokemon")
+	}
+
+	if !confirm(cfg, fmt.Sprintf("Release %s? It will be gone for good.", p)) {
+		fmt.Printf("%s stays with you\n", p.DisplayName())
+		return nil
+	}
+	if _, err := cfg.Release(id); err != nil {
+		return err
+	}
+	fmt.Printf("%s was released. Bye, %s!\n", p.DisplayName(), p.DisplayName())
+
+	return nil
+}
+
+// confirm asks a yes/no question on the REPL input, defaulting to no.
+func confirm(cfg *config.Clicfg, question string) bool {
+	if cfg.Input == nil {
+		return false
+	}
+
+	fmt.Printf("%s [y/N] ", question)
+	if !cfg.Input.Scan() {
+		return false
+	}
+	answer := strings.ToLower(strings.TrimSpace(cfg.Input.Text()))
+	return answer == "y" || answer == "yes"
+}
+
+// parsePokemonID reads a caught pokemon ID, with or without a leading #.
+func parsePokemonID(s string) (int, error) {
+	id, err := strconv.Atoi(strings.TrimPrefix(s, "#"))
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid pokemon id %q", s)
+	}
+	return id, nil
+}
+
+func pokemonSummary(p *config.Pokemon) string {
+	s := p.String()
+	if p.Location != "" {
+		s += ", caught in " + p.Location
+	}
+	if !p.CaughtAt.IsZero() {
+		s += " on " + p.CaughtAt.Format("2006-01-02 15:04")
+	}
+	return s
+}
@@ -7,14 +7,22 @@ import (
 )
 
 func commandPokedex(cfg *config.Clicfg, args []string) error {
-	if len(cfg.CaughtPokemon) >= 0 {
+	if len(cfg.CaughtPokemon) <= 0 {
 		fmt.Print("You have no pokemon...\n")
 		return nil
 	}
 
+	owned := map[string][]*config.Pokemon{}
+	for _, p := range cfg.Caught() {
+		owned[p.Species] = append(owned[p.Species], p)
+	}
+
 	fmt.Print("Your pokemon\n")
 	for i := range cfg.CaughtPokemon {
-		fmt.Printf("  - %s\n", cfg.CaughtPokemon[i])
+		fmt.Printf("  - %s\n", pokemonName(cfg, cfg.CaughtPokemon[i]))
+		for _, p := range owned[cfg.CaughtPokemon[i]] {
+			fmt.Printf("      %s\n", p)
+		}
 	}
 
 	return nil
@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+)
+
+func commandProfile(cfg *config.Clicfg, args []string) error {
+	if cfg.ProfileDir == "" {
+		return errors.New("profiles are unavailable without a config directory")
+	}
+	if len(args) <= 0 || args[0] == "" {
+		fmt.Printf("Current profile: %s\n", cfg.Profile)
+		return nil
+	}
+
+	if args[0] == "list" {
+		return subCommandProfileList(cfg)
+	}
+
+	subCommands := map[string]func(*config.Clicfg, string) error{
+		"new":    subCommandProfileNew,
+		"switch": subCommandProfileSwitch,
+		"delete": subCommandProfileDelete,
+	}
+	sub, ok := subCommands[args[0]]
+	if !ok {
+		return fmt.Errorf("unknown profile command %q", args[0])
+	}
+	if len(args) < 2 {
+		return fmt.Errorf("usage: profile %s <name>", args[0])
+	}
+	if err := config.ValidateProfileName(args[1]); err != nil {
+		return err
+	}
+
+	return sub(cfg, args[1])
+}
+
+func subCommandProfileList(cfg *config.Clicfg) error {
+	names, err := config.ListProfiles(cfg.ProfileDir)
+	if err != nil {
+		return err
+	}
+
+	current := false
+	for _, name := range names {
+		if name == cfg.Profile {
+			current = true
+			fmt.Printf("* %s\n", name)
+		} else {
+			fmt.Printf("  %s\n", name)
+		}
+	}
+	if !current {
+		fmt.Printf("* %s (unsaved)\n", cfg.Profile)
+	}
+
+	return nil
+}
+
+func subCommandProfileNew(cfg *config.Clicfg, name string) error {
+	path := config.ProfilePath(cfg.ProfileDir, name)
+	if _, err := os.Stat(path); err == nil || name == cfg.Profile {
+		return fmt.Errorf("profile %s already exists", name)
+	}
+
+	if err := config.SaveState(path, newState()); err != nil {
+		return err
+	}
+	fmt.Printf("Created profile %s\n", name)
+
+	return nil
+}
+
+func subCommandProfileSwitch(cfg *config.Clicfg, name string) error {
+	if name == cfg.Profile {
+		fmt.Printf("Already using profile %s\n", name)
+		return nil
+	}
+
+	path := config.ProfilePath(cfg.ProfileDir, name)
+	s, err := config.LoadState(path, abilityRoller(cfg))
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("no profile named %s, create it with: profile new %s", name, name)
+	} else if err != nil {
+		return err
+	}
+
+	// autosave is off when a corrupt save could not be moved aside
+	if cfg.StatePath != "" {
+		if err := config.SaveState(cfg.StatePath, cfg.State()); err != nil {
+			return err
+		}
+	}
+
+	cfg.ApplyState(s)
+	cfg.Wild = nil
+	cfg.Profile = name
+	cfg.StatePath = path
+	fmt.Printf("Switched to profile %s\n", name)
+
+	return nil
+}
+
+func subCommandProfileDelete(cfg *config.Clicfg, name string) error {
+	if name == cfg.Profile {
+		return errors.New("cannot delete the profile in use, switch to another one first")
+	}
+
+	err := os.Remove(config.ProfilePath(cfg.ProfileDir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("no profile named %s", name)
+	} else if err != nil {
+		return err
+	}
+	fmt.Printf("Deleted profile %s\n", name)
+
+	return nil
+}
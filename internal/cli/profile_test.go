@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+)
+
+func TestProfileSwitchWithoutSave(t *testing.T) {
+	dir := t.TempDir()
+	other := newState()
+	other.Money = 1234
+	if err := config.SaveState(config.ProfilePath(dir, "other"), other); err != nil {
+		t.Fatal(err)
+	}
+
+	// no StatePath, as after a corrupt save that could not be moved aside
+	cfg := &config.Clicfg{Profile: "default", ProfileDir: dir}
+	cfg.ApplyState(newState())
+	cfg.Wild = &config.WildPokemon{Name: "pikachu", Level: 5}
+
+	if _, err := captureStdout(t, func() error { return subCommandProfileSwitch(cfg, "other") }); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Profile != "other" || cfg.Money != 1234 {
+		t.Fatalf("switched to %s with %d money", cfg.Profile, cfg.Money)
+	}
+	if cfg.Wild != nil {
+		t.Fatal("the wild pokemon followed into the other profile")
+	}
+	if cfg.StatePath != config.ProfilePath(dir, "other") {
+		t.Fatalf("got state path %q", cfg.StatePath)
+	}
+}
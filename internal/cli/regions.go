@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+)
+
+func commandRegions(cfg *config.Clicfg, args []string) error {
+	respData, err := cfg.API.ListRegions()
+	if err != nil {
+		return err
+	}
+
+	for i := range respData.Results {
+		fmt.Printf("%s\n", respData.Results[i].Name)
+	}
+
+	return nil
+}
+
+func commandLocations(cfg *config.Clicfg, args []string) error {
+	if len(args) <= 0 || args[0] == "" {
+		return errors.New("no region argument given")
+	}
+
+	respData, err := cfg.API.GetRegion(args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Locations in %s:\n", respData.Name)
+	for i := range respData.Locations {
+		fmt.Printf(" - %s\n", respData.Locations[i].Name)
+	}
+
+	return nil
+}
+
+func commandAreas(cfg *config.Clicfg, args []string) error {
+	if len(args) <= 0 || args[0] == "" {
+		return errors.New("no location argument given")
+	}
+
+	respData, err := cfg.API.GetLocation(args[0])
+	if err != nil {
+		return err
+	}
+
+	if len(respData.Areas) <= 0 {
+		fmt.Printf("%s has no areas to explore\n", respData.Name)
+		return nil
+	}
+
+	fmt.Printf("Areas in %s:\n", respData.Name)
+	for i := range respData.Areas {
+		fmt.Printf(" - %s\n", respData.Areas[i].Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"testing"
+)
+
+// captureStdout returns what f prints to standard output.
+func captureStdout(t *testing.T, f func() error) (string, error) {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	ferr := f()
+	w.Close()
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out), ferr
+}
+
+func regionResponses() mapFetcher {
+	return mapFetcher{
+		"region/?offset=0&limit=100": `{"count":2,"results":[{"name":"kanto"},{"name":"johto"}]}`,
+		"region/kanto":               `{"name":"kanto","locations":[{"name":"pallet-town"},{"name":"viridian-forest"}]}`,
+		"location/pallet-town":       `{"name":"pallet-town","areas":[]}`,
+		"location/viridian-forest":   `{"name":"viridian-forest","areas":[{"name":"viridian-forest-area"}]}`,
+	}
+}
+
+func TestCommandRegions(t *testing.T) {
+	cfg := testCfg(regionResponses())
+	out, err := captureStdout(t, func() error { return commandRegions(cfg, nil) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != "kanto\njohto\n" {
+		t.Fatalf("got %q", out)
+	}
+
+	cfg = testCfg(mapFetcher{})
+	if _, err := captureStdout(t, func() error { return commandRegions(cfg, nil) }); err == nil {
+		t.Fatal("expected an error when the listing fails")
+	}
+}
+
+func TestCommandLocations(t *testing.T) {
+	cfg := testCfg(regionResponses())
+	out, err := captureStdout(t, func() error { return commandLocations(cfg, []string{"kanto"}) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Locations in kanto:\n - pallet-town\n - viridian-forest\n"; out != want {
+		t.Fatalf("got %q, want %q", out, want)
+	}
+
+	if err := commandLocations(cfg, nil); err == nil {
+		t.Fatal("expected an error without a region")
+	}
+	if _, err := captureStdout(t, func() error { return commandLocations(cfg, []string{"hoenn"}) }); err == nil {
+		t.Fatal("expected an error for an unknown region")
+	}
+}
+
+func TestCommandAreas(t *testing.T) {
+	cfg := testCfg(regionResponses())
+	out, err := captureStdout(t, func() error { return commandAreas(cfg, []string{"viridian-forest"}) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Areas in viridian-forest:\n - viridian-forest-area\n"; out != want {
+		t.Fatalf("got %q, want %q", out, want)
+	}
+
+	out, err = captureStdout(t, func() error { return commandAreas(cfg, []string{"pallet-town"}) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "pallet-town has no areas to explore\n"; out != want {
+		t.Fatalf("got %q, want %q", out, want)
+	}
+
+	if err := commandAreas(cfg, []string{""}); err == nil {
+		t.Fatal("expected an error without a location")
+	}
+}
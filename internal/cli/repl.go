@@ -2,35 +2,41 @@ package cli
 
 import (
 	"bufio"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
 )
 
-type responseData struct {
-	Count    int     `json:"count"`
-	Next     *string `json:"next"`
-	Previous *string `json:"previous"`
-	Results  []struct {
-		Name string `json:"name"`
-		URL  string `json:"url"`
-	} `json:"results"`
-}
-
 func Run() {
-	cfg := newCfg()
+	settings, err := loadSettings(os.Args[1:])
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	cfg, err := newCfg(settings)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer cfg.Cache.Close()
+	loadOnStartup(cfg)
 
 	scanner := bufio.NewScanner(os.Stdin)
+	cfg.Input = scanner
 	for {
 		fmt.Print("Pokedex > ")
-		scanner.Scan()
+		if !scanner.Scan() {
+			fmt.Println()
+			autosave(cfg)
+			return
+		}
 		args := strings.Split(scanner.Text(), " ")
 		cmd := strings.ToLower(args[0])
 
@@ -44,6 +50,7 @@ func Run() {
 			}
 			if err.Error() == "exit command" {
 				fmt.Println("exiting program...")
+				autosave(cfg)
 				return
 			} else {
 				fmt.Println(err)
@@ -52,16 +59,70 @@ func Run() {
 	}
 }
 
-func newCfg() *config.Clicfg {
-	url := "https://pokeapi.co/api/v2/location-area/?offset=0&limit=20"
+func newCfg(settings config.Settings) (*config.Clicfg, error) {
+	cache, err := newCache(settings)
+	if err != nil {
+		return nil, err
+	}
 
-	return &config.Clicfg{
-		Cache:         config.NewCache(time.Second * 3),
+	cfg := &config.Clicfg{
+		Cache:         cache,
 		Commands:      buildCommands(),
 		CaughtPokemon: []string{},
-		MapLast:       &url,
-		MapNext:       nil,
-		MapPrev:       nil,
+		Language:      settings.Language,
+	}
+	cfg.Cache.OnDiskError = diskErrorReporter()
+	cfg.API = pokeapi.NewClient(settings.BaseURL, &cfg.Cache)
+
+	cfg.ApplyState(newState())
+
+	cfg.Profile = settings.Profile
+	if dir, err := config.DefaultProfileDir(); err == nil {
+		if err := config.MigrateLegacySave(dir); err != nil {
+			return nil, err
+		}
+		cfg.ProfileDir = dir
+		cfg.StatePath = config.ProfilePath(dir, cfg.Profile)
+	}
+
+	return cfg, nil
+}
+
+// newCache prefers the persistent disk cache, falling back to a memory-only
+// cache when no cache directory is available. In offline mode the bundle
+// defaults to the cache directory, so anything fetched online before stays
+// available.
+func newCache(settings config.Settings) (config.Cache, error) {
+	dir, err := config.DefaultCacheDir()
+
+	if settings.Offline {
+		bundle := settings.Bundle
+		if bundle == "" {
+			if err != nil {
+				return config.Cache{}, err
+			}
+			bundle = dir
+		}
+		return config.NewOfflineCache(time.Second*3, bundle)
+	}
+
+	if err == nil {
+		c, err := config.NewDiskCache(time.Second*3, dir)
+		if err == nil {
+			return c, nil
+		}
+	}
+	return config.NewCache(time.Second * 3), nil
+}
+
+// diskErrorReporter warns about the first failed disk cache write only, a
+// full or read-only disk would otherwise fail every request the same way.
+func diskErrorReporter() func(error) {
+	once := sync.Once{}
+	return func(err error) {
+		once.Do(func() {
+			fmt.Printf("could not write the disk cache, responses are only kept for this session: %s\n", err)
+		})
 	}
 }
 
@@ -79,14 +140,29 @@ func buildCommands() map[string]config.CliCommand {
 		},
 		"map": {
 			Name:        "map",
-			Description: "Displays 20 map locations.",
+			Description: "Displays the next page of map locations: map [first|last] [--page N] [--limit N]",
 			Callback:    commandMap,
 		},
 		"mapb": {
 			Name:        "mapb",
-			Description: "Displays 20 map locations.",
+			Description: "Displays the previous page of map locations.",
 			Callback:    commandMapB,
 		},
+		"regions": {
+			Name:        "regions",
+			Description: "Lists all regions",
+			Callback:    commandRegions,
+		},
+		"locations": {
+			Name:        "locations",
+			Description: "Lists the locations in a region: locations <region>",
+			Callback:    commandLocations,
+		},
+		"areas": {
+			Name:        "areas",
+			Description: "Lists the explorable areas of a location: areas <location>",
+			Callback:    commandAreas,
+		},
 		"entries": {
 			Name:        "entries",
 			Description: "Displays urls of all cached entries",
@@ -94,12 +170,27 @@ func buildCommands() map[string]config.CliCommand {
 		},
 		"explore": {
 			Name:        "explore",
-			Description: "Displays Pokemon available in the area",
+			Description: "Moves to an area and displays its Pokemon: explore <area> [--version red] [--method walk] [--detail]",
 			Callback:    commandExplore,
 		},
+		"travel": {
+			Name:        "travel",
+			Description: "Shows your current area, or moves to another: travel <area>",
+			Callback:    commandTravel,
+		},
+		"encounter": {
+			Name:        "encounter",
+			Description: "Searches your area for a wild pokemon: encounter [--method walk] [--version red]",
+			Callback:    commandEncounter,
+		},
+		"walk": {
+			Name:        "walk",
+			Description: "Same as encounter",
+			Callback:    commandEncounter,
+		},
 		"catch": {
 			Name:        "catch",
-			Description: "Attempts to catch a pokemon",
+			Description: "Attempts to catch a pokemon living in your area: catch <pokemon> [--ball great-ball]",
 			Callback:    commandCatch,
 		},
 		"inspect": {
@@ -112,6 +203,116 @@ func buildCommands() map[string]config.CliCommand {
 			Description: "Lists the pokemon the user has caught",
 			Callback:    commandPokedex,
 		},
+		"party": {
+			Name:        "party",
+			Description: "Lists the pokemon in your party",
+			Callback:    commandParty,
+		},
+		"box": {
+			Name:        "box",
+			Description: "Lists your PC boxes, or the pokemon in one: box [n]",
+			Callback:    commandBox,
+		},
+		"deposit": {
+			Name:        "deposit",
+			Description: "Moves a party pokemon to a PC box: deposit <id> [box]",
+			Callback:    commandDeposit,
+		},
+		"withdraw": {
+			Name:        "withdraw",
+			Description: "Moves a boxed pokemon to your party: withdraw <id>",
+			Callback:    commandWithdraw,
+		},
+		"nickname": {
+			Name:        "nickname",
+			Description: "Names a caught pokemon, or clears its name: nickname <id> [name]",
+			Callback:    commandNickname,
+		},
+		"release": {
+			Name:        "release",
+			Description: "Releases a caught pokemon into the wild: release <id>",
+			Callback:    commandRelease,
+		},
+		"battle": {
+			Name:        "battle",
+			Description: "Battles the wild pokemon, or another party pokemon: battle <id> [opponent id]",
+			Callback:    commandBattle,
+		},
+		"dex": {
+			Name:        "dex",
+			Description: "Displays the pokedex entry of a pokemon: dex <pokemon> [--version red]",
+			Callback:    commandDex,
+		},
+		"moves": {
+			Name:        "moves",
+			Description: "Lists the moves a pokemon learns: moves <pokemon> [--version-group red-blue] [--method level-up]",
+			Callback:    commandMoves,
+		},
+		"move": {
+			Name:        "move",
+			Description: "Displays the details of a move: move <name>",
+			Callback:    commandMove,
+		},
+		"ability": {
+			Name:        "ability",
+			Description: "Displays an ability and the pokemon that can have it: ability <name>",
+			Callback:    commandAbility,
+		},
+		"language": {
+			Name:        "language",
+			Description: "Shows or changes the display language for this session: language [ja]",
+			Callback:    commandLanguage,
+		},
+		"search": {
+			Name:        "search",
+			Description: "Searches pokemon by type, ability, generation and stats: search type:fire speed>100 gen:1 ability:blaze [--sort speed] [--desc]",
+			Callback:    commandSearch,
+		},
+		"types": {
+			Name:        "types",
+			Description: "Displays the type effectiveness chart",
+			Callback:    commandTypes,
+		},
+		"matchup": {
+			Name:        "matchup",
+			Description: "Shows which attacking types are strong or weak against a pokemon: matchup <pokemon>",
+			Callback:    commandMatchup,
+		},
+		"evolution": {
+			Name:        "evolution",
+			Description: "Displays the evolution tree of a pokemon: evolution <pokemon>",
+			Callback:    commandEvolution,
+		},
+		"evolve": {
+			Name:        "evolve",
+			Description: "Evolves a caught pokemon by level or with an item: evolve <id> [item]",
+			Callback:    commandEvolve,
+		},
+		"shop": {
+			Name:        "shop",
+			Description: "Lists items for sale, or buys them: shop buy <item> [quantity]",
+			Callback:    commandShop,
+		},
+		"bag": {
+			Name:        "bag",
+			Description: "Lists the items in your bag and your money",
+			Callback:    commandBag,
+		},
+		"save": {
+			Name:        "save",
+			Description: "Saves your progress, optionally to the given file",
+			Callback:    commandSave,
+		},
+		"profile": {
+			Name:        "profile",
+			Description: "Manages trainer profiles: profile list|new|switch|delete <name>",
+			Callback:    commandProfile,
+		},
+		"load": {
+			Name:        "load",
+			Description: "Loads your progress, optionally from the given file",
+			Callback:    commandLoad,
+		},
 	}
 }
 
@@ -139,48 +340,82 @@ func commandEntries(cfg *config.Clicfg, args []string) error {
 }
 
 func commandMap(cfg *config.Clicfg, args []string) error {
-	return subCommandMap(cfg, cfg.MapNext)
-}
-
-func commandMapB(cfg *config.Clicfg, args []string) error {
-	return subCommandMap(cfg, cfg.MapPrev)
-}
-
-func subCommandMap(cfg *config.Clicfg, url *string) error {
-	if url == nil {
-		url = cfg.MapLast
+	args, flags, err := parseFlags(args, []string{"page", "limit"}, nil)
+	if err != nil {
+		return err
+	}
+	offset, limit := cfg.Map.Offset, cfg.Map.Limit
+	if len(args) <= 0 && len(flags) <= 0 {
+		if cfg.Map.HasNext {
+			offset += limit
+		}
+		return subCommandMap(cfg, offset, limit)
 	}
 
-	body, err := cfg.Cache.Get(*url)
-	if err != nil {
-		resp, err := http.Get(*url)
-		if err != nil {
-			return err
+	if flags["limit"] != "" {
+		limit, err = strconv.Atoi(flags["limit"])
+		if err != nil || limit <= 0 {
+			return fmt.Errorf("invalid page size %q", flags["limit"])
 		}
-		body, err = io.ReadAll(resp.Body)
-		resp.Body.Close()
-		if resp.StatusCode > 299 {
-			return errors.New("response failed")
+		// stay on the page holding the first area currently shown
+		offset = offset / limit * limit
+	}
+	if flags["page"] != "" {
+		page, err := strconv.Atoi(flags["page"])
+		if err != nil || page <= 0 {
+			return fmt.Errorf("invalid page %q", flags["page"])
 		}
-		if err != nil {
-			return err
+		offset = (page - 1) * limit
+	}
+
+	if len(args) > 0 {
+		switch args[0] {
+		case "first":
+			offset = 0
+		case "last":
+			respData, err := cfg.API.ListLocationAreas(0, limit)
+			if err != nil {
+				return err
+			}
+			offset = max(respData.Count-1, 0) / limit * limit
+		default:
+			return fmt.Errorf("unknown map command %q", args[0])
 		}
-		defer cfg.Cache.Add(*url, body)
 	}
 
-	respData := responseData{}
-	err = json.Unmarshal(body, &respData)
+	return subCommandMap(cfg, offset, limit)
+}
+
+func commandMapB(cfg *config.Clicfg, args []string) error {
+	offset := cfg.Map.Offset
+	if cfg.Map.HasPrev {
+		offset = max(offset-cfg.Map.Limit, 0)
+	}
+	return subCommandMap(cfg, offset, cfg.Map.Limit)
+}
+
+func subCommandMap(cfg *config.Clicfg, offset, limit int) error {
+	respData, err := cfg.API.ListLocationAreas(offset, limit)
 	if err != nil {
 		return err
 	}
 
-	cfg.MapLast = url
-	cfg.MapNext = respData.Next
-	cfg.MapPrev = respData.Previous
+	pages := max((respData.Count+limit-1)/limit, 1)
+	if len(respData.Results) <= 0 && respData.Count > 0 {
+		return fmt.Errorf("there are only %d pages of %d areas", pages, limit)
+	}
+
+	cfg.Map = config.MapPosition{
+		Offset:  offset,
+		Limit:   limit,
+		HasNext: respData.Next != nil,
+		HasPrev: respData.Previous != nil,
+	}
 
 	for i := range respData.Results {
-		fmt.Printf("%s\n", respData.Results[i].Name)
+		fmt.Printf("%s\n", areaName(cfg, respData.Results[i].Name))
 	}
+	fmt.Printf("-- page %d of %d --\n", offset/limit+1, pages)
 
 	return nil
 }
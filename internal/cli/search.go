@@ -0,0 +1,346 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+// searchStats maps the stat names a query or --sort can use to PokeAPI's
+// stat names. The total is the sum of all six.
+var searchStats = map[string]string{
+	"hp":              "hp",
+	"attack":          "attack",
+	"atk":             "attack",
+	"defense":         "defense",
+	"def":             "defense",
+	"special-attack":  "special-attack",
+	"spa":             "special-attack",
+	"special-defense": "special-defense",
+	"spd":             "special-defense",
+	"speed":           "speed",
+	"spe":             "speed",
+	"total":           "total",
+	"bst":             "total",
+}
+
+// searchOps are the stat comparisons, two character ones first so that
+// ">=" is not read as ">".
+var searchOps = []string{">=", "<=", "!=", ">", "<", "="}
+
+// searchTerm is one condition of a search query, either "field:value" for
+// the type, ability and gen fields or a stat comparison like "speed>100".
+type searchTerm struct {
+	field string
+	op    string
+	value string
+	n     int
+
+	// species holds the species of the generation for gen terms
+	species map[string]bool
+}
+
+func parseQuery(query []string) ([]searchTerm, error) {
+	terms := []searchTerm{}
+	for _, q := range query {
+		q = strings.ToLower(q)
+		if field, value, ok := strings.Cut(q, ":"); ok {
+			if field != "type" && field != "ability" && field != "gen" {
+				return nil, fmt.Errorf("unknown search field %q, use type, ability or gen", field)
+			}
+			if value == "" {
+				return nil, fmt.Errorf("no value given for %s", field)
+			}
+			terms = append(terms, searchTerm{field: field, op: ":", value: value})
+			continue
+		}
+
+		term := searchTerm{}
+		for _, op := range searchOps {
+			if i := strings.Index(q, op); i > 0 {
+				term.field, term.op, term.value = q[:i], op, q[i+len(op):]
+				break
+			}
+		}
+		if term.op == "" {
+			return nil, fmt.Errorf("cannot read search term %q, use field:value or stat>number", q)
+		}
+		stat, ok := searchStats[term.field]
+		if !ok {
+			return nil, fmt.Errorf("unknown stat %q", term.field)
+		}
+		n, err := strconv.Atoi(term.value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %q is not a number", q, term.value)
+		}
+		term.field, term.n = stat, n
+		terms = append(terms, term)
+	}
+	return terms, nil
+}
+
+func newSearchEntry(p pokeapi.Pokemon) config.SearchEntry {
+	e := config.SearchEntry{ID: p.ID, Name: p.Name, Species: p.Species.Name, Stats: map[string]int{}}
+	for _, t := range p.Types {
+		e.Types = append(e.Types, t.Type.Name)
+	}
+	for _, a := range p.Abilities {
+		e.Abilities = append(e.Abilities, a.Ability.Name)
+	}
+	for _, s := range p.Stats {
+		e.Stats[s.Stat.Name] = s.BaseStat
+		e.Stats["total"] += s.BaseStat
+	}
+	return e
+}
+
+// searchEntry looks pokemon up in the session's search index, fetching and
+// indexing it the first time.
+func searchEntry(cfg *config.Clicfg, pokemon string) (config.SearchEntry, error) {
+	if e, ok := cfg.SearchIndex[pokemon]; ok {
+		return e, nil
+	}
+	p, err := cfg.API.GetPokemon(pokemon)
+	if err != nil {
+		return config.SearchEntry{}, err
+	}
+	if cfg.SearchIndex == nil {
+		cfg.SearchIndex = map[string]config.SearchEntry{}
+	}
+	e := newSearchEntry(p)
+	cfg.SearchIndex[pokemon] = e
+	return e, nil
+}
+
+func (t searchTerm) matches(e config.SearchEntry) bool {
+	switch t.field {
+	case "type":
+		return slices.Contains(e.Types, t.value)
+	case "ability":
+		return slices.Contains(e.Abilities, t.value)
+	case "gen":
+		return t.species[e.Species]
+	}
+
+	v := e.Stats[t.field]
+	switch t.op {
+	case ">=":
+		return v >= t.n
+	case "<=":
+		return v <= t.n
+	case "!=":
+		return v != t.n
+	case ">":
+		return v > t.n
+	case "<":
+		return v < t.n
+	default:
+		return v == t.n
+	}
+}
+
+func commandSearch(cfg *config.Clicfg, args []string) error {
+	args, flags, err := parseFlags(args, []string{"sort"}, []string{"desc"})
+	if err != nil {
+		return err
+	}
+	if len(args) <= 0 {
+		return errors.New("no search query given, for example: search type:fire speed>100")
+	}
+	terms, err := parseQuery(args)
+	if err != nil {
+		return err
+	}
+
+	sortBy := strings.ToLower(flags["sort"])
+	if sortBy == "" {
+		sortBy = "id"
+	} else if stat, ok := searchStats[sortBy]; ok {
+		sortBy = stat
+	} else if sortBy != "id" && sortBy != "name" {
+		return fmt.Errorf("cannot sort by %q, use id, name or a stat", sortBy)
+	}
+
+	candidates, skipped, err := searchCandidates(cfg, terms)
+	if err != nil {
+		return err
+	}
+	unindexed := 0
+	for _, name := range candidates {
+		if _, ok := cfg.SearchIndex[name]; !ok {
+			unindexed++
+		}
+	}
+	if unindexed > 100 {
+		fmt.Printf("Indexing %d pokemon, this takes a while the first time...\n", unindexed)
+	}
+
+	found := []config.SearchEntry{}
+	for _, name := range candidates {
+		e, err := searchEntry(cfg, name)
+		if err != nil {
+			skipped = append(skipped, name)
+			continue
+		}
+		if !slices.ContainsFunc(terms, func(t searchTerm) bool { return !t.matches(e) }) {
+			found = append(found, e)
+		}
+	}
+
+	slices.SortStableFunc(found, func(a, b config.SearchEntry) int {
+		c := 0
+		switch sortBy {
+		case "id":
+			c = a.ID - b.ID
+		case "name":
+			c = strings.Compare(a.Name, b.Name)
+		default:
+			c = a.Stats[sortBy] - b.Stats[sortBy]
+		}
+		if flags["desc"] != "" {
+			c = -c
+		}
+		if c == 0 {
+			c = a.ID - b.ID
+		}
+		return c
+	})
+
+	if len(found) <= 0 {
+		fmt.Println("No pokemon match the search")
+	} else if err := printSearchTable(found); err != nil {
+		return err
+	}
+	if len(skipped) > 0 {
+		fmt.Printf("Skipped %d that could not be fetched: %s\n", len(skipped), strings.Join(skipped, ", "))
+	}
+
+	return nil
+}
+
+func printSearchTable(found []config.SearchEntry) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "#\tNAME\tTYPES\tHP\tATK\tDEF\tSPA\tSPD\tSPE\tTOTAL")
+	for _, e := range found {
+		fmt.Fprintf(w, "%d\t%s\t%s", e.ID, e.Name, strings.Join(e.Types, "/"))
+		for _, name := range config.StatNames {
+			fmt.Fprintf(w, "\t%d", e.Stats[name])
+		}
+		fmt.Fprintf(w, "\t%d\n", e.Stats["total"])
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Printf("%d pokemon found\n", len(found))
+
+	return nil
+}
+
+// searchCandidates resolves the type, ability and gen terms and lists the
+// pokemon worth indexing for the search. The type and ability listings
+// narrow it down cheaply, without them it falls back to the varieties of
+// the generation's species. Queries with none of these terms look at every
+// pokemon, which takes over a thousand requests the first time. Species
+// that could not be fetched are returned as skipped.
+func searchCandidates(cfg *config.Clicfg, terms []searchTerm) (candidates, skipped []string, err error) {
+	narrow := func(names []string) {
+		if candidates == nil {
+			candidates = names
+			return
+		}
+		candidates = slices.DeleteFunc(candidates, func(name string) bool {
+			return !slices.Contains(names, name)
+		})
+	}
+
+	var genSpecies []pokeapi.NamedAPIResource
+	for i := range terms {
+		t := &terms[i]
+		switch t.field {
+		case "type":
+			name, err := resolveName(cfg, "type", t.value)
+			if err != nil {
+				return nil, nil, err
+			}
+			typ, err := cfg.API.GetType(name)
+			if err != nil {
+				return nil, nil, err
+			}
+			t.value = typ.Name
+			names := []string{}
+			for _, p := range typ.Pokemon {
+				names = append(names, p.Pokemon.Name)
+			}
+			narrow(names)
+		case "ability":
+			name, err := resolveName(cfg, "ability", t.value)
+			if err != nil {
+				return nil, nil, err
+			}
+			ability, err := cfg.API.GetAbility(name)
+			if err != nil {
+				return nil, nil, err
+			}
+			t.value = ability.Name
+			names := []string{}
+			for _, p := range ability.Pokemon {
+				names = append(names, p.Pokemon.Name)
+			}
+			narrow(names)
+		case "gen":
+			gen, err := cfg.API.GetGeneration(generationName(t.value))
+			if err != nil {
+				return nil, nil, err
+			}
+			t.species = map[string]bool{}
+			for _, s := range gen.PokemonSpecies {
+				t.species[s.Name] = true
+			}
+			if genSpecies == nil {
+				genSpecies = gen.PokemonSpecies
+			}
+		}
+	}
+
+	if candidates == nil && genSpecies != nil {
+		candidates = []string{}
+		for _, species := range genSpecies {
+			s, err := cfg.API.GetPokemonSpecies(species.Name)
+			if err != nil {
+				skipped = append(skipped, species.Name)
+				continue
+			}
+			for _, v := range s.Varieties {
+				candidates = append(candidates, v.Pokemon.Name)
+			}
+		}
+	}
+
+	if candidates == nil {
+		all, err := cfg.API.ListAll("pokemon")
+		if err != nil {
+			return nil, nil, err
+		}
+		candidates = []string{}
+		for _, p := range all.Results {
+			candidates = append(candidates, p.Name)
+		}
+	}
+	return candidates, skipped, nil
+}
+
+// generationName accepts generations as numbers, roman numerals or their
+// full name.
+func generationName(gen string) string {
+	if _, err := strconv.Atoi(gen); err == nil || strings.HasPrefix(gen, "generation-") {
+		return gen
+	}
+	return "generation-" + gen
+}
@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+func TestParseQuery(t *testing.T) {
+	terms, err := parseQuery([]string{"type:fire", "Speed>=100", "spa<90", "gen:1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(terms) != 4 {
+		t.Fatalf("got %d terms", len(terms))
+	}
+	if terms[0].field != "type" || terms[0].value != "fire" {
+		t.Fatalf("got %+v", terms[0])
+	}
+	if terms[1].field != "speed" || terms[1].op != ">=" || terms[1].n != 100 {
+		t.Fatalf("got %+v", terms[1])
+	}
+	if terms[2].field != "special-attack" || terms[2].op != "<" || terms[2].n != 90 {
+		t.Fatalf("got %+v", terms[2])
+	}
+
+	for _, q := range []string{"color:red", "luck>5", "speed>fast", "speed", "type:"} {
+		if _, err := parseQuery([]string{q}); err == nil {
+			t.Fatalf("expected an error for %q", q)
+		}
+	}
+}
+
+func TestSearchTermMatches(t *testing.T) {
+	stat := func(name string, base int) pokeapi.PokemonStat {
+		return pokeapi.PokemonStat{BaseStat: base, Stat: pokeapi.NamedAPIResource{Name: name}}
+	}
+	e := newSearchEntry(pokeapi.Pokemon{
+		Name:      "charizard",
+		Species:   pokeapi.NamedAPIResource{Name: "charizard"},
+		Types:     []pokeapi.PokemonType{{Type: pokeapi.NamedAPIResource{Name: "fire"}}, {Type: pokeapi.NamedAPIResource{Name: "flying"}}},
+		Abilities: []pokeapi.PokemonAbility{{Ability: pokeapi.NamedAPIResource{Name: "blaze"}}},
+		Stats:     []pokeapi.PokemonStat{stat("hp", 78), stat("speed", 100)},
+	})
+
+	terms, err := parseQuery([]string{"type:flying", "ability:blaze", "speed>=100", "speed<101", "total=178", "hp!=80"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, term := range terms {
+		if !term.matches(e) {
+			t.Fatalf("%+v should match", term)
+		}
+	}
+
+	terms, _ = parseQuery([]string{"type:water", "speed>100", "gen:2"})
+	terms[2].species = map[string]bool{"chikorita": true}
+	for _, term := range terms {
+		if term.matches(e) {
+			t.Fatalf("%+v should not match", term)
+		}
+	}
+}
+
+func TestSearchCandidates(t *testing.T) {
+	cfg := testCfg(mapFetcher{
+		"type/?offset=0&limit=100000":    `{"results":[{"name":"fire"},{"name":"water"}]}`,
+		"ability/?offset=0&limit=100000": `{"results":[{"name":"solar-power"}]}`,
+		"type/fire":                      `{"name":"fire","pokemon":[{"pokemon":{"name":"charmander"}},{"pokemon":{"name":"charizard"}}]}`,
+		"ability/solar-power":            `{"name":"solar-power","pokemon":[{"pokemon":{"name":"charizard"}},{"pokemon":{"name":"sunflora"}}]}`,
+		"generation/1":                   `{"name":"generation-i","pokemon_species":[{"name":"bulbasaur"},{"name":"deoxys"}]}`,
+		"pokemon-species/bulbasaur":      `{"name":"bulbasaur","varieties":[{"is_default":true,"pokemon":{"name":"bulbasaur"}}]}`,
+	})
+
+	terms, _ := parseQuery([]string{"type:fire", "ability:solar-power"})
+	candidates, _, err := searchCandidates(cfg, terms)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candidates) != 1 || candidates[0] != "charizard" {
+		t.Fatalf("got %v", candidates)
+	}
+
+	terms, _ = parseQuery([]string{"gen:1"})
+	candidates, skipped, err := searchCandidates(cfg, terms)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candidates) != 1 || candidates[0] != "bulbasaur" {
+		t.Fatalf("got %v", candidates)
+	}
+	if len(skipped) != 1 || skipped[0] != "deoxys" {
+		t.Fatalf("failed species not reported as skipped, got %v", skipped)
+	}
+
+	terms, _ = parseQuery([]string{"speed>100"})
+	if _, _, err := searchCandidates(cfg, terms); err == nil {
+		t.Fatal("expected an error without the pokemon listing")
+	}
+}
+
+func TestSearchStatsOnly(t *testing.T) {
+	cfg := testCfg(mapFetcher{
+		"pokemon/?offset=0&limit=100000": `{"results":[{"name":"pikachu"},{"name":"jolteon"},{"name":"missingno"}]}`,
+		"pokemon/pikachu":                `{"id":25,"name":"pikachu","stats":[{"base_stat":90,"stat":{"name":"speed"}}]}`,
+		"pokemon/jolteon":                `{"id":135,"name":"jolteon","stats":[{"base_stat":130,"stat":{"name":"speed"}}]}`,
+	})
+
+	out, err := captureStdout(t, func() error { return commandSearch(cfg, []string{"speed>100"}) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "jolteon") || strings.Contains(out, "pikachu") {
+		t.Fatalf("got %q", out)
+	}
+	if !strings.Contains(out, "Skipped 1 that could not be fetched: missingno") {
+		t.Fatalf("failed pokemon not reported, got %q", out)
+	}
+
+	// the index is kept for the session
+	cfg.API = pokeapi.NewClient(testBaseURL, mapFetcher{
+		"pokemon/?offset=0&limit=100000": `{"results":[{"name":"pikachu"},{"name":"jolteon"}]}`,
+	})
+	out, err = captureStdout(t, func() error { return commandSearch(cfg, []string{"speed<100"}) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "pikachu") || strings.Contains(out, "Skipped") {
+		t.Fatalf("got %q", out)
+	}
+}
@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+var shopItems = []string{
+	"poke-ball", "great-ball", "ultra-ball", "master-ball",
+	"fire-stone", "water-stone", "thunder-stone", "leaf-stone", "moon-stone",
+}
+
+func commandShop(cfg *config.Clicfg, args []string) error {
+	args, _, err := parseFlags(args, nil, nil)
+	if err != nil {
+		return err
+	}
+	if len(args) <= 0 {
+		return subCommandShopList(cfg)
+	}
+	if args[0] != "buy" {
+		return fmt.Errorf("unknown shop command %q", args[0])
+	}
+	if len(args) < 2 {
+		return errors.New("usage: shop buy <item> [quantity]")
+	}
+
+	qty := 1
+	if len(args) > 2 {
+		qty, err = strconv.Atoi(args[2])
+		if err != nil || qty <= 0 {
+			return fmt.Errorf("invalid quantity %q", args[2])
+		}
+	}
+
+	return subCommandShopBuy(cfg, strings.ToLower(args[1]), qty)
+}
+
+func subCommandShopList(cfg *config.Clicfg) error {
+	fmt.Print("Poke Mart\n")
+	for _, name := range shopItems {
+		item, err := cfg.API.GetItem(name)
+		if err != nil {
+			return err
+		}
+		if item.Cost <= 0 {
+			fmt.Printf("  - %s: not for sale\n", item.Name)
+		} else {
+			fmt.Printf("  - %s: $%d\n", item.Name, item.Cost)
+		}
+	}
+	fmt.Printf("You have $%d\n", cfg.Money)
+
+	return nil
+}
+
+func subCommandShopBuy(cfg *config.Clicfg, name string, qty int) error {
+	if !slices.Contains(shopItems, name) {
+		return fmt.Errorf("the shop does not sell %s", name)
+	}
+
+	item, err := cfg.API.GetItem(name)
+	if err != nil {
+		return err
+	}
+	if item.Cost <= 0 {
+		return fmt.Errorf("%s is not for sale", item.Name)
+	}
+
+	if err := cfg.Buy(item.Name, item.Cost, qty); err != nil {
+		return err
+	}
+	fmt.Printf("Bought %d %s for $%d, $%d left\n", qty, item.Name, item.Cost*qty, cfg.Money)
+
+	return nil
+}
+
+func commandBag(cfg *config.Clicfg, args []string) error {
+	fmt.Printf("Money: $%d\n", cfg.Money)
+
+	if len(cfg.Inventory) <= 0 {
+		fmt.Print("Your bag is empty...\n")
+		return nil
+	}
+
+	names := []string{}
+	for name := range cfg.Inventory {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	fmt.Print("Your bag\n")
+	for _, name := range names {
+		fmt.Printf("  - %s x%d\n", name, cfg.Inventory[name])
+	}
+
+	return nil
+}
+
+var catchRateRe = regexp.MustCompile(`catch rate of ([0-9.]+)\s*×`)
+
+// ballBonus reads a ball's catch rate modifier from its effect text, falling
+// back to the known modifiers when the text does not state one.
+func ballBonus(item pokeapi.Item) (float64, error) {
+	if !strings.HasSuffix(item.Category.Name, "-balls") {
+		return 0, fmt.Errorf("%s is not a poke ball", item.Name)
+	}
+
+	if e, ok := pokeapi.EnglishEffect(item.EffectEntries); ok {
+		text := strings.ToLower(e.Effect + " " + e.ShortEffect)
+		if strings.Contains(text, "without fail") || strings.Contains(text, "every time") {
+			return masterBallBonus, nil
+		}
+		if m := catchRateRe.FindStringSubmatch(text); m != nil {
+			if v, err := strconv.ParseFloat(m[1], 64); err == nil {
+				return v, nil
+			}
+		}
+	}
+
+	if v, ok := ballBonuses[item.Name]; ok {
+		return v, nil
+	}
+	return 1, nil
+}
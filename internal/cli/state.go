@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+func commandSave(cfg *config.Clicfg, args []string) error {
+	path := cfg.StatePath
+	if len(args) > 0 && args[0] != "" {
+		path = args[0]
+	}
+	if path == "" {
+		return errors.New("no save file available")
+	}
+
+	if err := config.SaveState(path, cfg.State()); err != nil {
+		return err
+	}
+	fmt.Printf("Saved to %s\n", path)
+
+	return nil
+}
+
+func commandLoad(cfg *config.Clicfg, args []string) error {
+	path := cfg.StatePath
+	if len(args) > 0 && args[0] != "" {
+		path = args[0]
+	}
+	if path == "" {
+		return errors.New("no save file available")
+	}
+
+	s, err := config.LoadState(path, abilityRoller(cfg))
+	if err != nil {
+		return err
+	}
+	cfg.ApplyState(s)
+	fmt.Printf("Loaded %s\n", path)
+
+	return nil
+}
+
+// newState is the state of a trainer who has just started out.
+func newState() config.State {
+	return config.State{
+		Version:       config.StateVersion,
+		CaughtPokemon: []string{},
+		Inventory:     map[string]int{"poke-ball": config.StartingPokeBalls},
+		Money:         config.StartingMoney,
+		Map:           config.MapPosition{Limit: pokeapi.DefaultPageSize},
+	}
+}
+
+// loadOnStartup restores the previous session, treating a missing save file
+// as a fresh start. A save that cannot be loaded is moved aside so autosave
+// does not overwrite it, and the trainer starts over.
+func loadOnStartup(cfg *config.Clicfg) {
+	if cfg.StatePath == "" {
+		return
+	}
+
+	s, err := config.LoadState(cfg.StatePath, abilityRoller(cfg))
+	if errors.Is(err, fs.ErrNotExist) {
+		return
+	} else if err != nil {
+		fmt.Printf("could not load %s: %s\n", cfg.StatePath, err)
+		bad := cfg.StatePath + ".bad"
+		if err := os.Rename(cfg.StatePath, bad); err != nil {
+			fmt.Printf("could not move it aside, autosave is off: %s\n", err)
+			cfg.StatePath = ""
+			return
+		}
+		fmt.Printf("kept it as %s and started a fresh game\n", bad)
+		return
+	}
+	cfg.ApplyState(s)
+}
+
+func autosave(cfg *config.Clicfg) {
+	if cfg.StatePath == "" {
+		return
+	}
+	if err := config.SaveState(cfg.StatePath, cfg.State()); err != nil {
+		fmt.Printf("autosave failed: %s\n", err)
+	}
+}
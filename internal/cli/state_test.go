@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+)
+
+func TestLoadOnStartupCorruptSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "save.json")
+	if err := os.WriteFile(path, []byte(`{"version":`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &config.Clicfg{StatePath: path}
+	cfg.ApplyState(newState())
+	loadOnStartup(cfg)
+
+	if cfg.Money != config.StartingMoney {
+		t.Fatal("corrupt save did not leave a fresh game")
+	}
+	if cfg.StatePath != path {
+		t.Fatal("autosave turned off although the save was moved aside")
+	}
+	if _, err := os.Stat(path + ".bad"); err != nil {
+		t.Fatalf("corrupt save not kept: %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Fatal("corrupt save left in place for autosave to overwrite")
+	}
+}
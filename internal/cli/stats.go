@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/battle"
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/nature"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+// maxLevel is the highest level a pokemon can reach.
+const maxLevel = 100
+
+// actualStats computes p's stats at its level from the base stats of its
+// species, its IVs, EVs and nature.
+func actualStats(poke pokeapi.Pokemon, p *config.Pokemon) battle.Stats {
+	stat := func(name string) int {
+		return battle.CalcStat(poke.BaseStat(name), p.IVs.Get(name), p.EVs.Get(name), p.Level, nature.Modifier(p.Nature, name))
+	}
+	return battle.Stats{
+		HP:        battle.CalcHP(poke.BaseStat("hp"), p.IVs.HP, p.EVs.HP, p.Level),
+		Attack:    stat("attack"),
+		Defense:   stat("defense"),
+		SpAttack:  stat("special-attack"),
+		SpDefense: stat("special-defense"),
+		Speed:     stat("speed"),
+	}
+}
+
+// growthRate fetches the growth rate of a pokemon's species.
+func growthRate(cfg *config.Clicfg, pokemon string) (pokeapi.GrowthRate, error) {
+	species, err := speciesOf(cfg, pokemon)
+	if err != nil {
+		return pokeapi.GrowthRate{}, err
+	}
+	return cfg.API.GetGrowthRate(species.GrowthRate.Name)
+}
+
+// gainExperience rewards p for defeating foe at level: experience, effort
+// values, level ups and any evolution those unlock.
+func gainExperience(cfg *config.Clicfg, p *config.Pokemon, foe pokeapi.Pokemon, level int) error {
+	growth, err := growthRate(cfg, p.Species)
+	if err != nil {
+		return err
+	}
+
+	// pokemon from saves without experience start at their level's minimum
+	p.Exp = max(p.Exp, growth.ExperienceAt(p.Level))
+
+	gain := max(foe.BaseExperience*level/7, 1)
+	p.Exp += gain
+	fmt.Printf("%s gained %d exp.\n", p.DisplayName(), gain)
+
+	for _, s := range foe.Stats {
+		p.GainEVs(s.Stat.Name, s.Effort)
+	}
+
+	newLevel := min(growth.LevelFor(p.Exp), maxLevel)
+	if newLevel <= p.Level {
+		return nil
+	}
+	for p.Level < newLevel {
+		p.Level++
+		fmt.Printf("%s grew to level %d!\n", p.DisplayName(), p.Level)
+	}
+
+	target, err := evolutionTarget(cfg, p, "")
+	if err != nil {
+		return err
+	}
+	if target != "" {
+		evolve(cfg, p, target)
+	}
+
+	return nil
+}
+
+// statLine summarizes p's actual stats and progress. Without a growth rate,
+// when it cannot be fetched offline, the experience is left out.
+func statLine(poke pokeapi.Pokemon, p *config.Pokemon, growth *pokeapi.GrowthRate) string {
+	s := actualStats(poke, p)
+	line := fmt.Sprintf("HP %d, Atk %d, Def %d, SpA %d, SpD %d, Spe %d, %s nature",
+		s.HP, s.Attack, s.Defense, s.SpAttack, s.SpDefense, s.Speed, p.Nature)
+	if p.Ability != "" {
+		line += fmt.Sprintf(", %s ability", p.Ability)
+	}
+
+	if growth == nil {
+		return line
+	}
+	exp := max(p.Exp, growth.ExperienceAt(p.Level))
+	if p.Level < maxLevel {
+		line += fmt.Sprintf(", %d exp (%d to next level)", exp, growth.ExperienceAt(p.Level+1)-exp)
+	} else {
+		line += fmt.Sprintf(", %d exp", exp)
+	}
+	return line
+}
+
+// geneLine lists p's individual and effort values.
+func geneLine(p *config.Pokemon) string {
+	values := func(s config.Stats) string {
+		return fmt.Sprintf("HP %d, Atk %d, Def %d, SpA %d, SpD %d, Spe %d",
+			s.HP, s.Attack, s.Defense, s.SpecialAttack, s.SpecialDefense, s.Speed)
+	}
+	return fmt.Sprintf("IVs %s; EVs %s", values(p.IVs), values(p.EVs))
+}
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+func commandTravel(cfg *config.Clicfg, args []string) error {
+	args, _, err := parseFlags(args, nil, nil)
+	if err != nil {
+		return err
+	}
+	if len(args) <= 0 {
+		if cfg.Location == "" {
+			fmt.Print("You are not in any area, travel to one with: travel <area>\n")
+		} else {
+			fmt.Printf("You are in %s\n", cfg.Location)
+		}
+		return nil
+	}
+
+	area, err := cfg.API.GetLocationArea(args[0])
+	if err != nil {
+		return err
+	}
+	if cfg.Location != area.Name {
+		cfg.Wild = nil
+	}
+	cfg.Location = area.Name
+	fmt.Printf("You travelled to %s\n", area.Name)
+
+	return nil
+}
+
+// currentArea returns the location area the trainer is in.
+func currentArea(cfg *config.Clicfg) (pokeapi.LocationArea, error) {
+	if cfg.Location == "" {
+		return pokeapi.LocationArea{}, errors.New("you are not in any area, use explore or travel first")
+	}
+	return cfg.API.GetLocationArea(cfg.Location)
+}
+
+// rollAppearance decides whether the pokemon of enc shows up and at what
+// level. One of its game versions is picked at random, then a d100 roll is
+// matched against the chances of that version's encounter slots.
+func rollAppearance(enc pokeapi.PokemonEncounter, roll func(n int) int) (int, bool) {
+	if len(enc.VersionDetails) <= 0 {
+		return 0, false
+	}
+	vd := enc.VersionDetails[roll(len(enc.VersionDetails))]
+
+	r := roll(100)
+	acc := 0
+	for _, e := range vd.EncounterDetails {
+		acc += e.Chance
+		if r < acc {
+			return rollLevel(e.MinLevel, e.MaxLevel, roll), true
+		}
+	}
+	return 0, false
+}
+
+func rollLevel(minLevel, maxLevel int, roll func(n int) int) int {
+	if maxLevel <= minLevel {
+		return max(minLevel, 1)
+	}
+	return minLevel + roll(maxLevel-minLevel+1)
+}
@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/battle"
+	"github.com/Quorum-Code/bd-pokedex/internal/cli/config"
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+func commandTypes(cfg *config.Clicfg, args []string) error {
+	types, chart, err := typeChart(cfg)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	fmt.Fprint(w, "ATK\\DEF")
+	for _, def := range types {
+		fmt.Fprintf(w, "\t%s", typeAbbrev(def))
+	}
+	fmt.Fprintln(w)
+	for _, atk := range types {
+		fmt.Fprint(w, atk)
+		for _, def := range types {
+			fmt.Fprintf(w, "\t%s", multiplierCell(chart.Effectiveness(atk, []string{def})))
+		}
+		fmt.Fprintln(w)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Print("2 = super effective, ½ = not very effective, 0 = no effect, . = normal\n")
+
+	return nil
+}
+
+func commandMatchup(cfg *config.Clicfg, args []string) error {
+	if len(args) <= 0 || args[0] == "" {
+		return errors.New("no pokemon argument given")
+	}
+
+	respData, err := cfg.API.GetPokemon(args[0])
+	if err != nil {
+		return err
+	}
+	defTypes := []string{}
+	for _, t := range respData.Types {
+		defTypes = append(defTypes, t.Type.Name)
+	}
+
+	types, chart, err := typeChart(cfg)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s (%s) takes:\n", respData.Name, strings.Join(defTypes, "/"))
+	buckets := matchups(chart, types, defTypes)
+	for _, m := range matchupMultipliers {
+		if len(buckets[m]) > 0 {
+			fmt.Printf("  %sx from %s\n", multiplierLabel(m), strings.Join(buckets[m], ", "))
+		}
+	}
+
+	return nil
+}
+
+// matchupMultipliers are the multipliers matchup lists, neutral damage
+// left out.
+var matchupMultipliers = []float64{4, 2, 0.5, 0.25, 0}
+
+// matchups groups the attacking types by how much damage they deal to a
+// pokemon of defTypes.
+func matchups(chart battle.TypeChart, types, defTypes []string) map[float64][]string {
+	buckets := map[float64][]string{}
+	for _, t := range types {
+		m := chart.Effectiveness(t, defTypes)
+		buckets[m] = append(buckets[m], t)
+	}
+	return buckets
+}
+
+// typeChart returns the types pokemon can have and their damage relations,
+// fetched once per session. Types like "unknown" and "shadow" that no
+// pokemon uses are left out.
+func typeChart(cfg *config.Clicfg) ([]string, battle.TypeChart, error) {
+	if cfg.TypeChart != nil {
+		return cfg.BattleTypes, cfg.TypeChart, nil
+	}
+
+	list, err := cfg.API.ListTypes()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	types := []string{}
+	chart := battle.TypeChart{}
+	for _, r := range list.Results {
+		t, err := cfg.API.GetType(r.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(t.Pokemon) <= 0 {
+			continue
+		}
+		types = append(types, t.Name)
+		chart[t.Name] = damageRow(t)
+	}
+
+	cfg.BattleTypes, cfg.TypeChart = types, chart
+	return types, chart, nil
+}
+
+// damageRow is the multiplier of t's attacks against each type it does not
+// deal normal damage to.
+func damageRow(t pokeapi.Type) map[string]float64 {
+	row := map[string]float64{}
+	for _, d := range t.DamageRelations.DoubleDamageTo {
+		row[d.Name] = 2
+	}
+	for _, d := range t.DamageRelations.HalfDamageTo {
+		row[d.Name] = 0.5
+	}
+	for _, d := range t.DamageRelations.NoDamageTo {
+		row[d.Name] = 0
+	}
+	return row
+}
+
+func typeAbbrev(t string) string {
+	if len(t) > 3 {
+		return t[:3]
+	}
+	return t
+}
+
+func multiplierCell(m float64) string {
+	switch m {
+	case 1:
+		return "."
+	case 0.5:
+		return "½"
+	default:
+		return multiplierLabel(m)
+	}
+}
+
+func multiplierLabel(m float64) string {
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.2f", m), "0"), ".")
+}
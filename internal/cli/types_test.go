@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Quorum-Code/bd-pokedex/internal/pokeapi"
+)
+
+func typeResponses() mapFetcher {
+	return mapFetcher{
+		"type/?offset=0&limit=100": `{"count":6,"results":[{"name":"fire"},{"name":"water"},{"name":"grass"},{"name":"ground"},{"name":"flying"},{"name":"unknown"}]}`,
+		"type/fire":                `{"name":"fire","pokemon":[{"pokemon":{"name":"charmander"}}],"damage_relations":{"double_damage_to":[{"name":"grass"}],"half_damage_to":[{"name":"fire"},{"name":"water"}]}}`,
+		"type/water":               `{"name":"water","pokemon":[{"pokemon":{"name":"squirtle"}}],"damage_relations":{"double_damage_to":[{"name":"fire"},{"name":"ground"}],"half_damage_to":[{"name":"water"},{"name":"grass"}]}}`,
+		"type/grass":               `{"name":"grass","pokemon":[{"pokemon":{"name":"bulbasaur"}}],"damage_relations":{"double_damage_to":[{"name":"water"},{"name":"ground"}],"half_damage_to":[{"name":"fire"},{"name":"grass"},{"name":"flying"}]}}`,
+		"type/ground":              `{"name":"ground","pokemon":[{"pokemon":{"name":"diglett"}}],"damage_relations":{"double_damage_to":[{"name":"fire"}],"half_damage_to":[{"name":"grass"}],"no_damage_to":[{"name":"flying"}]}}`,
+		"type/flying":              `{"name":"flying","pokemon":[{"pokemon":{"name":"pidgey"}}],"damage_relations":{"double_damage_to":[{"name":"grass"}]}}`,
+		"type/unknown":             `{"name":"unknown","pokemon":[]}`,
+	}
+}
+
+func TestTypeChart(t *testing.T) {
+	cfg := testCfg(typeResponses())
+	types, chart, err := typeChart(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"fire", "water", "grass", "ground", "flying"}; !slices.Equal(types, want) {
+		t.Fatalf("got types %v, want %v", types, want)
+	}
+
+	cases := []struct {
+		atk  string
+		def  []string
+		want float64
+	}{
+		{"fire", []string{"grass"}, 2},
+		{"fire", []string{"water"}, 0.5},
+		{"fire", []string{"flying"}, 1},
+		{"ground", []string{"flying"}, 0},
+		{"water", []string{"fire", "ground"}, 4},
+		{"grass", []string{"fire", "flying"}, 0.25},
+	}
+	for _, c := range cases {
+		if m := chart.Effectiveness(c.atk, c.def); m != c.want {
+			t.Fatalf("%s against %v: got %v, want %v", c.atk, c.def, m, c.want)
+		}
+	}
+
+	// later calls are served from the session without fetching
+	cfg.API = pokeapi.NewClient(testBaseURL, mapFetcher{})
+	if _, _, err := typeChart(cfg); err != nil {
+		t.Fatalf("chart fetched again: %v", err)
+	}
+}
+
+func TestMatchups(t *testing.T) {
+	types, chart, err := typeChart(testCfg(typeResponses()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		def  []string
+		want map[float64][]string
+	}{
+		{[]string{"fire", "ground"}, map[float64][]string{4: {"water"}, 2: {"ground"}, 0.5: {"fire"}, 1: {"grass", "flying"}}},
+		{[]string{"grass", "flying"}, map[float64][]string{2: {"fire", "flying"}, 0.5: {"water"}, 0.25: {"grass"}, 0: {"ground"}}},
+	}
+	for _, c := range cases {
+		got := matchups(chart, types, c.def)
+		if len(got) != len(c.want) {
+			t.Fatalf("%v: got %v, want %v", c.def, got, c.want)
+		}
+		for m, atk := range c.want {
+			if !slices.Equal(got[m], atk) {
+				t.Fatalf("%v at %vx: got %v, want %v", c.def, m, got[m], atk)
+			}
+		}
+	}
+}
+
+func TestMultiplierLabel(t *testing.T) {
+	cases := []struct {
+		m           float64
+		label, cell string
+	}{
+		{4, "4", "4"},
+		{2, "2", "2"},
+		{1, "1", "."},
+		{0.5, "0.5", "½"},
+		{0.25, "0.25", "0.25"},
+		{0, "0", "0"},
+	}
+	for _, c := range cases {
+		if l := multiplierLabel(c.m); l != c.label {
+			t.Fatalf("label of %v: got %q, want %q", c.m, l, c.label)
+		}
+		if l := multiplierCell(c.m); l != c.cell {
+			t.Fatalf("cell of %v: got %q, want %q", c.m, l, c.cell)
+		}
+	}
+}
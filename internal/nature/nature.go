@@ -0,0 +1,55 @@
+// Package nature holds the table of pokemon natures, shared by the saved
+// pokemon that roll them and the battle stats they modify.
+package nature
+
+import "slices"
+
+// Table maps each nature to the stat it raises and the stat it lowers by
+// 10%. Neutral natures raise and lower nothing.
+var Table = map[string][2]string{
+	"hardy": {}, "docile": {}, "serious": {}, "bashful": {}, "quirky": {},
+	"lonely":  {"attack", "defense"},
+	"brave":   {"attack", "speed"},
+	"adamant": {"attack", "special-attack"},
+	"naughty": {"attack", "special-defense"},
+	"bold":    {"defense", "attack"},
+	"relaxed": {"defense", "speed"},
+	"impish":  {"defense", "special-attack"},
+	"lax":     {"defense", "special-defense"},
+	"timid":   {"speed", "attack"},
+	"hasty":   {"speed", "defense"},
+	"jolly":   {"speed", "special-attack"},
+	"naive":   {"speed", "special-defense"},
+	"modest":  {"special-attack", "attack"},
+	"mild":    {"special-attack", "defense"},
+	"quiet":   {"special-attack", "speed"},
+	"rash":    {"special-attack", "special-defense"},
+	"calm":    {"special-defense", "attack"},
+	"gentle":  {"special-defense", "defense"},
+	"sassy":   {"special-defense", "speed"},
+	"careful": {"special-defense", "special-attack"},
+}
+
+// Names lists the natures in a stable order.
+func Names() []string {
+	names := []string{}
+	for n := range Table {
+		names = append(names, n)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Modifier is the multiplier nature applies to stat.
+func Modifier(nature, stat string) float64 {
+	n := Table[nature]
+	switch stat {
+	case "":
+		return 1
+	case n[0]:
+		return 1.1
+	case n[1]:
+		return 0.9
+	}
+	return 1
+}
@@ -0,0 +1,19 @@
+package pokeapi
+
+// Ability is the /ability/{name} resource.
+type Ability struct {
+	ID            int              `json:"id"`
+	Name          string           `json:"name"`
+	IsMainSeries  bool             `json:"is_main_series"`
+	Generation    NamedAPIResource `json:"generation"`
+	EffectEntries []VerboseEffect  `json:"effect_entries"`
+	Names         []Name           `json:"names"`
+	Pokemon       []AbilityPokemon `json:"pokemon"`
+}
+
+// AbilityPokemon is a pokemon that can have an ability, and in which slot.
+type AbilityPokemon struct {
+	IsHidden bool             `json:"is_hidden"`
+	Slot     int              `json:"slot"`
+	Pokemon  NamedAPIResource `json:"pokemon"`
+}
@@ -0,0 +1,192 @@
+package pokeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const DefaultBaseURL = "https://pokeapi.co/api/v2/"
+
+// Fetcher returns the raw body for a URL, failing on non-2xx responses.
+// config.Cache satisfies it.
+type Fetcher interface {
+	Get(url string) ([]byte, error)
+}
+
+type Client struct {
+	baseURL string
+	fetcher Fetcher
+}
+
+func NewClient(baseURL string, fetcher Fetcher) *Client {
+	if !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+	return &Client{baseURL, fetcher}
+}
+
+// endpoint builds "<base><resource>/<name>", escaping name.
+func (c *Client) endpoint(resource, name string) string {
+	return c.baseURL + resource + "/" + url.PathEscape(strings.ToLower(name))
+}
+
+// listAllLimit is a page size larger than any PokeAPI listing.
+const listAllLimit = 100000
+
+// listURL builds a paginated listing URL for resource.
+func (c *Client) listURL(resource string, offset, limit int) string {
+	// built by hand in the order PokeAPI writes its next and previous
+	// links, url.Values would sort limit first and cache the same page
+	// under two keys
+	return fmt.Sprintf("%s%s/?offset=%d&limit=%d", c.baseURL, resource, offset, limit)
+}
+
+func (c *Client) get(url string, v any) error {
+	body, err := c.fetcher.Get(url)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
+func (c *Client) getNamed(resource, name string, v any) error {
+	if name == "" {
+		return fmt.Errorf("no %s name given", resource)
+	}
+	if err := c.get(c.endpoint(resource, name), v); err != nil {
+		return fmt.Errorf("%s %q: %w", resource, name, err)
+	}
+	return nil
+}
+
+func (c *Client) GetPokemon(name string) (Pokemon, error) {
+	p := Pokemon{}
+	err := c.getNamed("pokemon", name, &p)
+	return p, err
+}
+
+func (c *Client) GetPokemonSpecies(name string) (PokemonSpecies, error) {
+	s := PokemonSpecies{}
+	err := c.getNamed("pokemon-species", name, &s)
+	return s, err
+}
+
+// GetEvolutionChain fetches the chain with id, see
+// PokemonSpecies.EvolutionChainID.
+func (c *Client) GetEvolutionChain(id int) (EvolutionChain, error) {
+	e := EvolutionChain{}
+	err := c.getNamed("evolution-chain", strconv.Itoa(id), &e)
+	return e, err
+}
+
+func (c *Client) GetGrowthRate(name string) (GrowthRate, error) {
+	g := GrowthRate{}
+	err := c.getNamed("growth-rate", name, &g)
+	return g, err
+}
+
+func (c *Client) GetGeneration(name string) (Generation, error) {
+	g := Generation{}
+	err := c.getNamed("generation", name, &g)
+	return g, err
+}
+
+func (c *Client) GetItem(name string) (Item, error) {
+	i := Item{}
+	err := c.getNamed("item", name, &i)
+	return i, err
+}
+
+func (c *Client) GetMove(name string) (Move, error) {
+	m := Move{}
+	err := c.getNamed("move", name, &m)
+	return m, err
+}
+
+func (c *Client) GetAbility(name string) (Ability, error) {
+	a := Ability{}
+	err := c.getNamed("ability", name, &a)
+	return a, err
+}
+
+func (c *Client) GetType(name string) (Type, error) {
+	t := Type{}
+	err := c.getNamed("type", name, &t)
+	return t, err
+}
+
+func (c *Client) GetLocationArea(name string) (LocationArea, error) {
+	a := LocationArea{}
+	err := c.getNamed("location-area", name, &a)
+	return a, err
+}
+
+func (c *Client) GetLocation(name string) (Location, error) {
+	l := Location{}
+	err := c.getNamed("location", name, &l)
+	return l, err
+}
+
+func (c *Client) GetRegion(name string) (Region, error) {
+	r := Region{}
+	err := c.getNamed("region", name, &r)
+	return r, err
+}
+
+// ListRegions returns every region; there are few enough to fit one page.
+func (c *Client) ListRegions() (NamedAPIResourceList, error) {
+	return c.ListPage(c.listURL("region", 0, 100))
+}
+
+// ListTypes returns every type; there are few enough to fit one page.
+func (c *Client) ListTypes() (NamedAPIResourceList, error) {
+	return c.ListPage(c.listURL("type", 0, 100))
+}
+
+// LocationAreasURL is the listing URL for a page of location areas.
+func (c *Client) LocationAreasURL(offset, limit int) string {
+	return c.listURL("location-area", offset, limit)
+}
+
+func (c *Client) ListLocationAreas(offset, limit int) (NamedAPIResourceList, error) {
+	return c.ListPage(c.LocationAreasURL(offset, limit))
+}
+
+// ListAll fetches every resource of a kind in a single listing, such as all
+// pokemon names.
+func (c *Client) ListAll(resource string) (NamedAPIResourceList, error) {
+	return c.ListPage(c.listURL(resource, 0, listAllLimit))
+}
+
+// ListPage fetches a listing page by URL, as found in the Next and Previous
+// links of a previous page.
+func (c *Client) ListPage(pageURL string) (NamedAPIResourceList, error) {
+	l := NamedAPIResourceList{}
+	err := c.get(pageURL, &l)
+	return l, err
+}
+
+// DefaultPageSize is the page size PokeAPI uses when none is requested.
+const DefaultPageSize = 20
+
+// PageOf returns the offset and limit of a listing page URL, defaulting to
+// the first page of DefaultPageSize.
+func PageOf(pageURL string) (int, int) {
+	offset, limit := 0, DefaultPageSize
+
+	u, err := url.Parse(pageURL)
+	if err != nil {
+		return offset, limit
+	}
+	q := u.Query()
+	if v, err := strconv.Atoi(q.Get("offset")); err == nil && v >= 0 {
+		offset = v
+	}
+	if v, err := strconv.Atoi(q.Get("limit")); err == nil && v > 0 {
+		limit = v
+	}
+	return offset, limit
+}
@@ -0,0 +1,101 @@
+package pokeapi
+
+import (
+	"errors"
+	"testing"
+)
+
+type mapFetcher map[string]string
+
+func (m mapFetcher) Get(url string) ([]byte, error) {
+	v, ok := m[url]
+	if !ok {
+		return nil, errors.New("failed response")
+	}
+	return []byte(v), nil
+}
+
+func TestGetPokemon(t *testing.T) {
+	c := NewClient("http://stub/api/v2", mapFetcher{
+		"http://stub/api/v2/pokemon/pikachu": `{"id":25,"name":"pikachu","types":[{"slot":1,"type":{"name":"electric"}}]}`,
+	})
+
+	p, err := c.GetPokemon("Pikachu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.ID != 25 || p.Name != "pikachu" {
+		t.Fatalf("unexpected pokemon %d %q", p.ID, p.Name)
+	}
+	if len(p.Types) != 1 || p.Types[0].Type.Name != "electric" {
+		t.Fatal("pokemon types not decoded")
+	}
+}
+
+func TestGetPokemonMissing(t *testing.T) {
+	c := NewClient("http://stub/api/v2/", mapFetcher{})
+
+	_, err := c.GetPokemon("missingno")
+	if err == nil {
+		t.Fatal("expected error for missing pokemon")
+	}
+}
+
+func TestListLocationAreas(t *testing.T) {
+	c := NewClient("http://stub/api/v2/", mapFetcher{
+		"http://stub/api/v2/location-area/?offset=0&limit=20": `{"count":2,"next":"http://stub/api/v2/location-area/?offset=20&limit=20","results":[{"name":"a"},{"name":"b"}]}`,
+	})
+
+	l, err := c.ListLocationAreas(0, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Count != 2 || len(l.Results) != 2 || l.Next == nil {
+		t.Fatal("location area list not decoded")
+	}
+}
+
+func TestPageOf(t *testing.T) {
+	offset, limit := PageOf("https://pokeapi.co/api/v2/location-area/?offset=40&limit=10")
+	if offset != 40 || limit != 10 {
+		t.Fatalf("got offset %d limit %d", offset, limit)
+	}
+
+	offset, limit = PageOf("https://pokeapi.co/api/v2/location-area/")
+	if offset != 0 || limit != DefaultPageSize {
+		t.Fatalf("got offset %d limit %d without a query", offset, limit)
+	}
+}
+
+func TestGrowthRateLevels(t *testing.T) {
+	g := GrowthRate{}
+	for _, l := range []struct{ level, exp int }{{1, 0}, {2, 8}, {3, 27}, {4, 64}} {
+		g.Levels = append(g.Levels, struct {
+			Level      int `json:"level"`
+			Experience int `json:"experience"`
+		}{l.level, l.exp})
+	}
+
+	if exp := g.ExperienceAt(3); exp != 27 {
+		t.Fatalf("experience at level 3 = %d, want 27", exp)
+	}
+	if level := g.LevelFor(30); level != 3 {
+		t.Fatalf("level for 30 exp = %d, want 3", level)
+	}
+	if level := g.LevelFor(0); level != 1 {
+		t.Fatalf("level for 0 exp = %d, want 1", level)
+	}
+}
+
+func TestFlavorText(t *testing.T) {
+	cases := []struct{ in, want string }{
+		{"It keeps its tail\nraised.\fStores electricity.", "It keeps its tail raised. Stores electricity."},
+		{"ほっぺたの　りょうがわに\nちいさい　でんきぶくろを　もつ。", "ほっぺたの　りょうがわに ちいさい　でんきぶくろを　もつ。"},
+		{"It uses electri­\ncity.", "It uses electricity."},
+	}
+	for _, c := range cases {
+		if got := (FlavorText{FlavorText: c.in}).Text(); got != c.want {
+			t.Fatalf("got %q, want %q", got, c.want)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package pokeapi
+
+// EvolutionChain is the /evolution-chain/{id} resource.
+type EvolutionChain struct {
+	ID    int       `json:"id"`
+	Chain ChainLink `json:"chain"`
+}
+
+// ChainLink is one species in an evolution chain and what it evolves into.
+type ChainLink struct {
+	IsBaby           bool              `json:"is_baby"`
+	Species          NamedAPIResource  `json:"species"`
+	EvolutionDetails []EvolutionDetail `json:"evolution_details"`
+	EvolvesTo        []ChainLink       `json:"evolves_to"`
+}
+
+// EvolutionDetail is one way of evolving into a ChainLink's species. Unset
+// conditions are nil or empty.
+type EvolutionDetail struct {
+	Trigger               NamedAPIResource  `json:"trigger"`
+	Item                  *NamedAPIResource `json:"item"`
+	Gender                *int              `json:"gender"`
+	HeldItem              *NamedAPIResource `json:"held_item"`
+	KnownMove             *NamedAPIResource `json:"known_move"`
+	KnownMoveType         *NamedAPIResource `json:"known_move_type"`
+	Location              *NamedAPIResource `json:"location"`
+	MinLevel              *int              `json:"min_level"`
+	MinHappiness          *int              `json:"min_happiness"`
+	MinBeauty             *int              `json:"min_beauty"`
+	MinAffection          *int              `json:"min_affection"`
+	NeedsOverworldRain    bool              `json:"needs_overworld_rain"`
+	PartySpecies          *NamedAPIResource `json:"party_species"`
+	PartyType             *NamedAPIResource `json:"party_type"`
+	RelativePhysicalStats *int              `json:"relative_physical_stats"`
+	TimeOfDay             string            `json:"time_of_day"`
+	TradeSpecies          *NamedAPIResource `json:"trade_species"`
+	TurnUpsideDown        bool              `json:"turn_upside_down"`
+}
+
+// Find returns the link of species within the chain starting at l.
+func (l *ChainLink) Find(species string) (*ChainLink, bool) {
+	if l.Species.Name == species {
+		return l, true
+	}
+	for i := range l.EvolvesTo {
+		if found, ok := l.EvolvesTo[i].Find(species); ok {
+			return found, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,10 @@
+package pokeapi
+
+// Generation is the /generation/{name} resource.
+type Generation struct {
+	ID             int                `json:"id"`
+	Name           string             `json:"name"`
+	MainRegion     NamedAPIResource   `json:"main_region"`
+	PokemonSpecies []NamedAPIResource `json:"pokemon_species"`
+	Names          []Name             `json:"names"`
+}
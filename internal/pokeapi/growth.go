@@ -0,0 +1,34 @@
+package pokeapi
+
+// GrowthRate is the /growth-rate/{name} resource.
+type GrowthRate struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Formula string `json:"formula"`
+	Levels  []struct {
+		Level      int `json:"level"`
+		Experience int `json:"experience"`
+	} `json:"levels"`
+}
+
+// ExperienceAt is the total experience needed to reach level.
+func (g GrowthRate) ExperienceAt(level int) int {
+	exp := 0
+	for _, l := range g.Levels {
+		if l.Level <= level && l.Experience > exp {
+			exp = l.Experience
+		}
+	}
+	return exp
+}
+
+// LevelFor is the level reached with exp total experience.
+func (g GrowthRate) LevelFor(exp int) int {
+	level := 1
+	for _, l := range g.Levels {
+		if l.Experience <= exp && l.Level > level {
+			level = l.Level
+		}
+	}
+	return level
+}
@@ -0,0 +1,11 @@
+package pokeapi
+
+// Item is the /item/{name} resource.
+type Item struct {
+	ID            int              `json:"id"`
+	Name          string           `json:"name"`
+	Cost          int              `json:"cost"`
+	Category      NamedAPIResource `json:"category"`
+	EffectEntries []VerboseEffect  `json:"effect_entries"`
+	Names         []Name           `json:"names"`
+}
@@ -0,0 +1,69 @@
+package pokeapi
+
+// LocationArea is the /location-area/{name} resource.
+type LocationArea struct {
+	EncounterMethodRates []EncounterMethodRate `json:"encounter_method_rates"`
+	GameIndex            int                   `json:"game_index"`
+	ID                   int                   `json:"id"`
+	Location             NamedAPIResource      `json:"location"`
+	Name                 string                `json:"name"`
+	Names                []Name                `json:"names"`
+	PokemonEncounters    []PokemonEncounter    `json:"pokemon_encounters"`
+}
+
+type EncounterMethodRate struct {
+	EncounterMethod NamedAPIResource       `json:"encounter_method"`
+	VersionDetails  []EncounterVersionRate `json:"version_details"`
+}
+
+type EncounterVersionRate struct {
+	Rate    int              `json:"rate"`
+	Version NamedAPIResource `json:"version"`
+}
+
+type PokemonEncounter struct {
+	Pokemon        NamedAPIResource         `json:"pokemon"`
+	VersionDetails []VersionEncounterDetail `json:"version_details"`
+}
+
+type VersionEncounterDetail struct {
+	EncounterDetails []Encounter      `json:"encounter_details"`
+	MaxChance        int              `json:"max_chance"`
+	Version          NamedAPIResource `json:"version"`
+}
+
+type Encounter struct {
+	Chance          int              `json:"chance"`
+	ConditionValues []any            `json:"condition_values"`
+	MaxLevel        int              `json:"max_level"`
+	Method          NamedAPIResource `json:"method"`
+	MinLevel        int              `json:"min_level"`
+}
+
+// Encounter returns how pokemon can be encountered in the area.
+func (a LocationArea) Encounter(pokemon string) (PokemonEncounter, bool) {
+	for _, e := range a.PokemonEncounters {
+		if e.Pokemon.Name == pokemon {
+			return e, true
+		}
+	}
+	return PokemonEncounter{}, false
+}
+
+// Location is the /location/{name} resource.
+type Location struct {
+	ID     int                `json:"id"`
+	Name   string             `json:"name"`
+	Region NamedAPIResource   `json:"region"`
+	Names  []Name             `json:"names"`
+	Areas  []NamedAPIResource `json:"areas"`
+}
+
+// Region is the /region/{name} resource.
+type Region struct {
+	ID             int                `json:"id"`
+	Name           string             `json:"name"`
+	Names          []Name             `json:"names"`
+	Locations      []NamedAPIResource `json:"locations"`
+	MainGeneration NamedAPIResource   `json:"main_generation"`
+}
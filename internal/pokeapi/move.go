@@ -0,0 +1,17 @@
+package pokeapi
+
+// Move is the /move/{name} resource. Power and Accuracy are nil for moves
+// that deal no direct damage or never miss.
+type Move struct {
+	ID            int              `json:"id"`
+	Name          string           `json:"name"`
+	Accuracy      *int             `json:"accuracy"`
+	EffectChance  *int             `json:"effect_chance"`
+	PP            int              `json:"pp"`
+	Priority      int              `json:"priority"`
+	Power         *int             `json:"power"`
+	DamageClass   NamedAPIResource `json:"damage_class"`
+	Type          NamedAPIResource `json:"type"`
+	EffectEntries []VerboseEffect  `json:"effect_entries"`
+	Names         []Name           `json:"names"`
+}
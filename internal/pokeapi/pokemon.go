@@ -0,0 +1,256 @@
+package pokeapi
+
+// Pokemon is the /pokemon/{name} resource.
+type Pokemon struct {
+	ID             int                `json:"id,omitempty"`
+	Name           string             `json:"name,omitempty"`
+	BaseExperience int                `json:"base_experience,omitempty"`
+	Height         int                `json:"height,omitempty"`
+	IsDefault      bool               `json:"is_default,omitempty"`
+	Order          int                `json:"order,omitempty"`
+	Weight         int                `json:"weight,omitempty"`
+	Abilities      []PokemonAbility   `json:"abilities,omitempty"`
+	Forms          []NamedAPIResource `json:"forms,omitempty"`
+	GameIndices    []struct {
+		GameIndex int              `json:"game_index,omitempty"`
+		Version   NamedAPIResource `json:"version,omitempty"`
+	} `json:"game_indices,omitempty"`
+	HeldItems []struct {
+		Item           NamedAPIResource `json:"item,omitempty"`
+		VersionDetails []struct {
+			Rarity  int              `json:"rarity,omitempty"`
+			Version NamedAPIResource `json:"version,omitempty"`
+		} `json:"version_details,omitempty"`
+	} `json:"held_items,omitempty"`
+	LocationAreaEncounters string           `json:"location_area_encounters,omitempty"`
+	Moves                  []PokemonMove    `json:"moves,omitempty"`
+	Species                NamedAPIResource `json:"species,omitempty"`
+	Sprites                struct {
+		BackDefault      string `json:"back_default,omitempty"`
+		BackFemale       any    `json:"back_female,omitempty"`
+		BackShiny        string `json:"back_shiny,omitempty"`
+		BackShinyFemale  any    `json:"back_shiny_female,omitempty"`
+		FrontDefault     string `json:"front_default,omitempty"`
+		FrontFemale      any    `json:"front_female,omitempty"`
+		FrontShiny       string `json:"front_shiny,omitempty"`
+		FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
+		Other            struct {
+			DreamWorld struct {
+				FrontDefault string `json:"front_default,omitempty"`
+				FrontFemale  any    `json:"front_female,omitempty"`
+			} `json:"dream_world,omitempty"`
+			Home struct {
+				FrontDefault     string `json:"front_default,omitempty"`
+				FrontFemale      any    `json:"front_female,omitempty"`
+				FrontShiny       string `json:"front_shiny,omitempty"`
+				FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
+			} `json:"home,omitempty"`
+			OfficialArtwork struct {
+				FrontDefault string `json:"front_default,omitempty"`
+				FrontShiny   string `json:"front_shiny,omitempty"`
+			} `json:"official-artwork,omitempty"`
+			Showdown struct {
+				BackDefault      string `json:"back_default,omitempty"`
+				BackFemale       any    `json:"back_female,omitempty"`
+				BackShiny        string `json:"back_shiny,omitempty"`
+				BackShinyFemale  any    `json:"back_shiny_female,omitempty"`
+				FrontDefault     string `json:"front_default,omitempty"`
+				FrontFemale      any    `json:"front_female,omitempty"`
+				FrontShiny       string `json:"front_shiny,omitempty"`
+				FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
+			} `json:"showdown,omitempty"`
+		} `json:"other,omitempty"`
+		Versions struct {
+			GenerationI struct {
+				RedBlue struct {
+					BackDefault  string `json:"back_default,omitempty"`
+					BackGray     string `json:"back_gray,omitempty"`
+					FrontDefault string `json:"front_default,omitempty"`
+					FrontGray    string `json:"front_gray,omitempty"`
+				} `json:"red-blue,omitempty"`
+				Yellow struct {
+					BackDefault  string `json:"back_default,omitempty"`
+					BackGray     string `json:"back_gray,omitempty"`
+					FrontDefault string `json:"front_default,omitempty"`
+					FrontGray    string `json:"front_gray,omitempty"`
+				} `json:"yellow,omitempty"`
+			} `json:"generation-i,omitempty"`
+			GenerationIi struct {
+				Crystal struct {
+					BackDefault  string `json:"back_default,omitempty"`
+					BackShiny    string `json:"back_shiny,omitempty"`
+					FrontDefault string `json:"front_default,omitempty"`
+					FrontShiny   string `json:"front_shiny,omitempty"`
+				} `json:"crystal,omitempty"`
+				Gold struct {
+					BackDefault  string `json:"back_default,omitempty"`
+					BackShiny    string `json:"back_shiny,omitempty"`
+					FrontDefault string `json:"front_default,omitempty"`
+					FrontShiny   string `json:"front_shiny,omitempty"`
+				} `json:"gold,omitempty"`
+				Silver struct {
+					BackDefault  string `json:"back_default,omitempty"`
+					BackShiny    string `json:"back_shiny,omitempty"`
+					FrontDefault string `json:"front_default,omitempty"`
+					FrontShiny   string `json:"front_shiny,omitempty"`
+				} `json:"silver,omitempty"`
+			} `json:"generation-ii,omitempty"`
+			GenerationIii struct {
+				Emerald struct {
+					FrontDefault string `json:"front_default,omitempty"`
+					FrontShiny   string `json:"front_shiny,omitempty"`
+				} `json:"emerald,omitempty"`
+				FireredLeafgreen struct {
+					BackDefault  string `json:"back_default,omitempty"`
+					BackShiny    string `json:"back_shiny,omitempty"`
+					FrontDefault string `json:"front_default,omitempty"`
+					FrontShiny   string `json:"front_shiny,omitempty"`
+				} `json:"firered-leafgreen,omitempty"`
+				RubySapphire struct {
+					BackDefault  string `json:"back_default,omitempty"`
+					BackShiny    string `json:"back_shiny,omitempty"`
+					FrontDefault string `json:"front_default,omitempty"`
+					FrontShiny   string `json:"front_shiny,omitempty"`
+				} `json:"ruby-sapphire,omitempty"`
+			} `json:"generation-iii,omitempty"`
+			GenerationIv struct {
+				DiamondPearl struct {
+					BackDefault      string `json:"back_default,omitempty"`
+					BackFemale       any    `json:"back_female,omitempty"`
+					BackShiny        string `json:"back_shiny,omitempty"`
+					BackShinyFemale  any    `json:"back_shiny_female,omitempty"`
+					FrontDefault     string `json:"front_default,omitempty"`
+					FrontFemale      any    `json:"front_female,omitempty"`
+					FrontShiny       string `json:"front_shiny,omitempty"`
+					FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
+				} `json:"diamond-pearl,omitempty"`
+				HeartgoldSoulsilver struct {
+					BackDefault      string `json:"back_default,omitempty"`
+					BackFemale       any    `json:"back_female,omitempty"`
+					BackShiny        string `json:"back_shiny,omitempty"`
+					BackShinyFemale  any    `json:"back_shiny_female,omitempty"`
+					FrontDefault     string `json:"front_default,omitempty"`
+					FrontFemale      any    `json:"front_female,omitempty"`
+					FrontShiny       string `json:"front_shiny,omitempty"`
+					FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
+				} `json:"heartgold-soulsilver,omitempty"`
+				Platinum struct {
+					BackDefault      string `json:"back_default,omitempty"`
+					BackFemale       any    `json:"back_female,omitempty"`
+					BackShiny        string `json:"back_shiny,omitempty"`
+					BackShinyFemale  any    `json:"back_shiny_female,omitempty"`
+					FrontDefault     string `json:"front_default,omitempty"`
+					FrontFemale      any    `json:"front_female,omitempty"`
+					FrontShiny       string `json:"front_shiny,omitempty"`
+					FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
+				} `json:"platinum,omitempty"`
+			} `json:"generation-iv,omitempty"`
+			GenerationV struct {
+				BlackWhite struct {
+					Animated struct {
+						BackDefault      string `json:"back_default,omitempty"`
+						BackFemale       any    `json:"back_female,omitempty"`
+						BackShiny        string `json:"back_shiny,omitempty"`
+						BackShinyFemale  any    `json:"back_shiny_female,omitempty"`
+						FrontDefault     string `json:"front_default,omitempty"`
+						FrontFemale      any    `json:"front_female,omitempty"`
+						FrontShiny       string `json:"front_shiny,omitempty"`
+						FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
+					} `json:"animated,omitempty"`
+					BackDefault      string `json:"back_default,omitempty"`
+					BackFemale       any    `json:"back_female,omitempty"`
+					BackShiny        string `json:"back_shiny,omitempty"`
+					BackShinyFemale  any    `json:"back_shiny_female,omitempty"`
+					FrontDefault     string `json:"front_default,omitempty"`
+					FrontFemale      any    `json:"front_female,omitempty"`
+					FrontShiny       string `json:"front_shiny,omitempty"`
+					FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
+				} `json:"black-white,omitempty"`
+			} `json:"generation-v,omitempty"`
+			GenerationVi struct {
+				OmegarubyAlphasapphire struct {
+					FrontDefault     string `json:"front_default,omitempty"`
+					FrontFemale      any    `json:"front_female,omitempty"`
+					FrontShiny       string `json:"front_shiny,omitempty"`
+					FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
+				} `json:"omegaruby-alphasapphire,omitempty"`
+				XY struct {
+					FrontDefault     string `json:"front_default,omitempty"`
+					FrontFemale      any    `json:"front_female,omitempty"`
+					FrontShiny       string `json:"front_shiny,omitempty"`
+					FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
+				} `json:"x-y,omitempty"`
+			} `json:"generation-vi,omitempty"`
+			GenerationVii struct {
+				Icons struct {
+					FrontDefault string `json:"front_default,omitempty"`
+					FrontFemale  any    `json:"front_female,omitempty"`
+				} `json:"icons,omitempty"`
+				UltraSunUltraMoon struct {
+					FrontDefault     string `json:"front_default,omitempty"`
+					FrontFemale      any    `json:"front_female,omitempty"`
+					FrontShiny       string `json:"front_shiny,omitempty"`
+					FrontShinyFemale any    `json:"front_shiny_female,omitempty"`
+				} `json:"ultra-sun-ultra-moon,omitempty"`
+			} `json:"generation-vii,omitempty"`
+			GenerationViii struct {
+				Icons struct {
+					FrontDefault string `json:"front_default,omitempty"`
+					FrontFemale  any    `json:"front_female,omitempty"`
+				} `json:"icons,omitempty"`
+			} `json:"generation-viii,omitempty"`
+		} `json:"versions,omitempty"`
+	} `json:"sprites,omitempty"`
+	Cries struct {
+		Latest string `json:"latest,omitempty"`
+		Legacy string `json:"legacy,omitempty"`
+	} `json:"cries,omitempty"`
+	Stats     []PokemonStat `json:"stats,omitempty"`
+	Types     []PokemonType `json:"types,omitempty"`
+	PastTypes []struct {
+		Generation NamedAPIResource `json:"generation,omitempty"`
+		Types      []struct {
+			Slot int              `json:"slot,omitempty"`
+			Type NamedAPIResource `json:"type,omitempty"`
+		} `json:"types,omitempty"`
+	} `json:"past_types,omitempty"`
+}
+
+type PokemonAbility struct {
+	IsHidden bool             `json:"is_hidden,omitempty"`
+	Slot     int              `json:"slot,omitempty"`
+	Ability  NamedAPIResource `json:"ability,omitempty"`
+}
+
+type PokemonMove struct {
+	Move                NamedAPIResource     `json:"move,omitempty"`
+	VersionGroupDetails []PokemonMoveVersion `json:"version_group_details,omitempty"`
+}
+
+type PokemonMoveVersion struct {
+	LevelLearnedAt  int              `json:"level_learned_at,omitempty"`
+	VersionGroup    NamedAPIResource `json:"version_group,omitempty"`
+	MoveLearnMethod NamedAPIResource `json:"move_learn_method,omitempty"`
+}
+
+type PokemonStat struct {
+	BaseStat int              `json:"base_stat,omitempty"`
+	Effort   int              `json:"effort,omitempty"`
+	Stat     NamedAPIResource `json:"stat,omitempty"`
+}
+
+type PokemonType struct {
+	Slot int              `json:"slot,omitempty"`
+	Type NamedAPIResource `json:"type,omitempty"`
+}
+
+// BaseStat returns the base value of the named stat, or 0 if the pokemon
+// does not have it.
+func (p Pokemon) BaseStat(name string) int {
+	for _, s := range p.Stats {
+		if s.Stat.Name == name {
+			return s.BaseStat
+		}
+	}
+	return 0
+}
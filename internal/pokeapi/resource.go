@@ -0,0 +1,64 @@
+package pokeapi
+
+import (
+	"path"
+	"strconv"
+	"strings"
+)
+
+// NamedAPIResource is a reference to another resource by name and URL.
+type NamedAPIResource struct {
+	Name string `json:"name,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+// ID is the numeric ID at the end of the resource URL, which for pokemon
+// is the national dex number.
+func (r NamedAPIResource) ID() (int, bool) {
+	id, err := strconv.Atoi(path.Base(strings.TrimSuffix(r.URL, "/")))
+	return id, err == nil
+}
+
+// NamedAPIResourceList is one page of a resource listing endpoint.
+type NamedAPIResourceList struct {
+	Count    int                `json:"count"`
+	Next     *string            `json:"next"`
+	Previous *string            `json:"previous"`
+	Results  []NamedAPIResource `json:"results"`
+}
+
+// Name is a resource name localized to a language.
+type Name struct {
+	Name     string           `json:"name"`
+	Language NamedAPIResource `json:"language"`
+}
+
+// VerboseEffect is an effect description localized to a language.
+type VerboseEffect struct {
+	Effect      string           `json:"effect"`
+	ShortEffect string           `json:"short_effect"`
+	Language    NamedAPIResource `json:"language"`
+}
+
+// EnglishEffect returns the English entry of effects, if there is one.
+func EnglishEffect(effects []VerboseEffect) (VerboseEffect, bool) {
+	for _, e := range effects {
+		if e.Language.Name == "en" {
+			return e, true
+		}
+	}
+	return VerboseEffect{}, false
+}
+
+// Languages are the language codes PokeAPI localizes names and text into.
+var Languages = []string{"en", "ja", "ja-Hrkt", "roomaji", "ko", "zh-Hans", "zh-Hant", "fr", "de", "es", "it", "cs", "pt-BR"}
+
+// LocalizedName returns the entry of names in language, if there is one.
+func LocalizedName(names []Name, language string) (string, bool) {
+	for _, n := range names {
+		if n.Language.Name == language {
+			return n.Name, true
+		}
+	}
+	return "", false
+}
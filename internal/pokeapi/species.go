@@ -0,0 +1,96 @@
+package pokeapi
+
+import "strings"
+
+// PokemonSpecies is the /pokemon-species/{name} resource.
+type PokemonSpecies struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	CaptureRate int    `json:"capture_rate"`
+	IsBaby      bool   `json:"is_baby"`
+	IsLegendary bool   `json:"is_legendary"`
+	IsMythical  bool   `json:"is_mythical"`
+	Names       []Name `json:"names"`
+
+	Genera            []Genus      `json:"genera"`
+	FlavorTextEntries []FlavorText `json:"flavor_text_entries"`
+
+	GrowthRate NamedAPIResource   `json:"growth_rate"`
+	Generation NamedAPIResource   `json:"generation"`
+	Color      NamedAPIResource   `json:"color"`
+	Shape      *NamedAPIResource  `json:"shape"`
+	Habitat    *NamedAPIResource  `json:"habitat"`
+	EggGroups  []NamedAPIResource `json:"egg_groups"`
+
+	Varieties []struct {
+		IsDefault bool             `json:"is_default"`
+		Pokemon   NamedAPIResource `json:"pokemon"`
+	} `json:"varieties"`
+
+	EvolvesFromSpecies *NamedAPIResource `json:"evolves_from_species"`
+	EvolutionChain     struct {
+		URL string `json:"url"`
+	} `json:"evolution_chain"`
+}
+
+// EvolutionChainID is the ID of the species' evolution chain, taken from
+// its link so the chain is fetched from the configured base URL.
+func (s PokemonSpecies) EvolutionChainID() (int, bool) {
+	return NamedAPIResource{URL: s.EvolutionChain.URL}.ID()
+}
+
+// Genus is a species' category, like "Mouse Pokémon", in a language.
+type Genus struct {
+	Genus    string           `json:"genus"`
+	Language NamedAPIResource `json:"language"`
+}
+
+// FlavorText is a pokedex entry from one game version.
+type FlavorText struct {
+	FlavorText string           `json:"flavor_text"`
+	Language   NamedAPIResource `json:"language"`
+	Version    NamedAPIResource `json:"version"`
+}
+
+// flavorBreaks turns the line and page breaks of the games' text boxes
+// into spaces. Other whitespace is kept as is, Japanese and Chinese entries
+// use full-width spaces between phrases. A soft hyphen before a break joins
+// the halves of a split word.
+var flavorBreaks = strings.NewReplacer("\u00ad\n", "", "\n", " ", "\f", " ")
+
+// Text is the entry with the text box breaks turned into spaces.
+func (f FlavorText) Text() string {
+	return flavorBreaks.Replace(f.FlavorText)
+}
+
+// DefaultVariety is the name of the species' default pokemon, which differs
+// from the species name for some, like deoxys-normal.
+func (s PokemonSpecies) DefaultVariety() string {
+	for _, v := range s.Varieties {
+		if v.IsDefault {
+			return v.Pokemon.Name
+		}
+	}
+	return s.Name
+}
+
+// Genus returns the species' genus in language, or "" if there is none.
+func (s PokemonSpecies) Genus(language string) string {
+	for _, g := range s.Genera {
+		if g.Language.Name == language {
+			return g.Genus
+		}
+	}
+	return ""
+}
+
+// FlavorTexts returns the species' pokedex entries in language.
+func (s PokemonSpecies) FlavorTexts(language string) []FlavorText {
+	entries := []FlavorText{}
+	for _, f := range s.FlavorTextEntries {
+		if f.Language.Name == language {
+			entries = append(entries, f)
+		}
+	}
+	return entries
+}
@@ -0,0 +1,20 @@
+package pokeapi
+
+// Type is the /type/{name} resource.
+type Type struct {
+	ID              int    `json:"id"`
+	Name            string `json:"name"`
+	DamageRelations struct {
+		NoDamageTo       []NamedAPIResource `json:"no_damage_to"`
+		HalfDamageTo     []NamedAPIResource `json:"half_damage_to"`
+		DoubleDamageTo   []NamedAPIResource `json:"double_damage_to"`
+		NoDamageFrom     []NamedAPIResource `json:"no_damage_from"`
+		HalfDamageFrom   []NamedAPIResource `json:"half_damage_from"`
+		DoubleDamageFrom []NamedAPIResource `json:"double_damage_from"`
+	} `json:"damage_relations"`
+	Pokemon []struct {
+		Slot    int              `json:"slot"`
+		Pokemon NamedAPIResource `json:"pokemon"`
+	} `json:"pokemon"`
+	Names []Name `json:"names"`
+}